@@ -0,0 +1,17 @@
+// dcgen is a command line tool for designing datacenters with the generator.
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/cli"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := cli.Run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
@@ -2,6 +2,7 @@ package datacenterAggregate
 
 import (
 	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
 )
@@ -41,6 +42,8 @@ func (a *DatacenterAggregate) When(event events.Event) error {
 		return a.onPodAdd(event)
 	case eventsv1.DatacenterRackAdded:
 		return a.onRackAdd(event)
+	case eventsv1.DatacenterDeviceAdded:
+		return a.onDeviceAdd(event)
 	default:
 		return events.ErrInvalidEventType
 	}
@@ -67,12 +70,7 @@ func (a *DatacenterAggregate) onPodAdd(event events.Event) error {
 		return err
 	}
 
-	pod := datacenter.NewPod()
-	pod.ID = data.PodId
-	pod.Datacenter = a.Datacenter
-
-	a.Datacenter.Pods = append(a.Datacenter.Pods, pod)
-
+	a.addPod(data.PodId, data.Function)
 	return nil
 }
 
@@ -82,11 +80,48 @@ func (a *DatacenterAggregate) onRackAdd(event events.Event) error {
 		return err
 	}
 
+	a.addRack(data.RackId)
+	return nil
+}
+
+func (a *DatacenterAggregate) onDeviceAdd(event events.Event) error {
+	var data eventsv1.DatacenterDeviceAddedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	a.addDevice(data.DeviceId, data.ModelId, data.Variant, data.Instance)
+	return nil
+}
+
+func (a *DatacenterAggregate) addPod(podId string, function datacenter.Function) {
+	pod := datacenter.NewPod()
+	pod.ID = podId
+	pod.Function = function
+	pod.Datacenter = a.Datacenter
+
+	a.Datacenter.Pods = append(a.Datacenter.Pods, pod)
+	if function != datacenter.UnknownFunction {
+		a.Datacenter.CountPod(function)
+	}
+}
+
+func (a *DatacenterAggregate) addRack(rackId string) {
 	rack := datacenter.NewRack()
-	rack.ID = data.RackId
+	rack.ID = rackId
 	rack.Datacenter = a.Datacenter
 
 	a.Datacenter.Racks = append(a.Datacenter.Racks, rack)
+}
 
-	return nil
+func (a *DatacenterAggregate) addDevice(deviceId, modelId, variant string, instance int) {
+	device := datacenter.NewDevice()
+	device.ID = deviceId
+	device.Model = hardware.HardwareModel{ID: modelId}
+	device.Variant = variant
+	device.Instance = instance
+	device.Datacenter = a.Datacenter
+
+	a.Datacenter.Devices = append(a.Datacenter.Devices, device)
+	a.Datacenter.CountDevice(modelId, variant)
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
 )
@@ -42,12 +43,18 @@ func (a *DatacenterAggregate) CreateDatacenter(ctx context.Context, site string,
 	return a.Apply(event)
 }
 
-func (a *DatacenterAggregate) AddPod(ctx context.Context, podId string) error {
+func (a *DatacenterAggregate) AddPod(ctx context.Context, podId string, function datacenter.Function) error {
 	if podId == "" {
 		return ErrPodIDNotProvided
 	}
 
-	event, err := eventsv1.NewDatacenterPodAddedEvent(a, podId)
+	for _, pod := range a.Datacenter.Pods {
+		if pod.ID == podId {
+			return fmt.Errorf("%w {%s}", ErrPodAlreadyAdded, podId)
+		}
+	}
+
+	event, err := eventsv1.NewDatacenterPodAddedEvent(a, podId, function)
 	if err != nil {
 		return err
 	}
@@ -60,6 +67,12 @@ func (a *DatacenterAggregate) AddRack(ctx context.Context, rackId string) error
 		return ErrRackIDNotProvided
 	}
 
+	for _, rack := range a.Datacenter.Racks {
+		if rack.ID == rackId {
+			return fmt.Errorf("%w {%s}", ErrRackAlreadyAdded, rackId)
+		}
+	}
+
 	event, err := eventsv1.NewDatacenterRackAddedEvent(a, rackId)
 	if err != nil {
 		return err
@@ -67,3 +80,24 @@ func (a *DatacenterAggregate) AddRack(ctx context.Context, rackId string) error
 
 	return a.Apply(event)
 }
+
+// AddDevice records a device created in the datacenter under the instance it was named after, counting it towards the
+// instances of its model and variant.
+func (a *DatacenterAggregate) AddDevice(ctx context.Context, deviceId, modelId, variant string, instance int) error {
+	if deviceId == "" {
+		return ErrDeviceIDNotProvided
+	}
+
+	for _, device := range a.Datacenter.Devices {
+		if device.ID == deviceId {
+			return fmt.Errorf("%w {%s}", ErrDeviceAlreadyAdded, deviceId)
+		}
+	}
+
+	event, err := eventsv1.NewDatacenterDeviceAddedEvent(a, deviceId, modelId, variant, instance)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
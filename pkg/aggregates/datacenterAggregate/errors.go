@@ -7,4 +7,8 @@ var (
 	ErrInvalidProviderTransferSpeed = errors.New("invalid provider transfer speed")
 	ErrPodIDNotProvided             = errors.New("podID not provided")
 	ErrRackIDNotProvided            = errors.New("rackID not provided")
+	ErrDeviceIDNotProvided          = errors.New("deviceID not provided")
+	ErrDeviceAlreadyAdded           = errors.New("device already added")
+	ErrPodAlreadyAdded              = errors.New("pod already added")
+	ErrRackAlreadyAdded             = errors.New("rack already added")
 )
@@ -0,0 +1,114 @@
+package datacenterAggregate
+
+import (
+	"encoding/json"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// snapshotSchemaVersion is the version of datacenterSnapshot. it must be incremented whenever datacenterSnapshot
+// changes so that snapshots written with the previous schema are discarded and rebuilt.
+const snapshotSchemaVersion = 4
+
+type datacenterSnapshot struct {
+	ID        string                 `json:"id"`
+	Site      string                 `json:"site"`
+	Building  string                 `json:"building"`
+	Room      string                 `json:"room"`
+	Providers map[string]units.Value `json:"providers"`
+	RackIds   []string               `json:"rackIds"`
+	Pods      []podSnapshot          `json:"pods"`
+	Devices   []deviceSnapshot       `json:"devices"`
+}
+
+type podSnapshot struct {
+	PodId    string              `json:"podId"`
+	Function datacenter.Function `json:"function,omitempty"`
+}
+
+type deviceSnapshot struct {
+	DeviceId string `json:"deviceId"`
+	ModelId  string `json:"modelId"`
+	Variant  string `json:"variant"`
+	Instance int    `json:"instance,omitempty"`
+}
+
+// snapshotSerializer is the events.SnapshotSerializer for DatacenterAggregate.
+type snapshotSerializer struct{}
+
+func NewSnapshotSerializer() *snapshotSerializer {
+	return &snapshotSerializer{}
+}
+
+func (s *snapshotSerializer) AggregateType() events.AggregateType {
+	return DatacenterAggregateType
+}
+
+func (s *snapshotSerializer) SchemaVersion() int {
+	return snapshotSchemaVersion
+}
+
+func (s *snapshotSerializer) Serialize(aggregate events.Aggregate) ([]byte, error) {
+	a, ok := aggregate.(*DatacenterAggregate)
+	if !ok {
+		return nil, events.ErrInvalidAggregate
+	}
+
+	snapshot := datacenterSnapshot{
+		ID:        a.Datacenter.ID,
+		Site:      a.Datacenter.Site,
+		Building:  a.Datacenter.Building,
+		Room:      a.Datacenter.Room,
+		Providers: a.Datacenter.Providers,
+		RackIds:   make([]string, 0, len(a.Datacenter.Racks)),
+		Pods:      make([]podSnapshot, 0, len(a.Datacenter.Pods)),
+		Devices:   make([]deviceSnapshot, 0, len(a.Datacenter.Devices)),
+	}
+	for _, rack := range a.Datacenter.Racks {
+		snapshot.RackIds = append(snapshot.RackIds, rack.ID)
+	}
+	for _, pod := range a.Datacenter.Pods {
+		snapshot.Pods = append(snapshot.Pods, podSnapshot{PodId: pod.ID, Function: pod.Function})
+	}
+	for _, device := range a.Datacenter.Devices {
+		snapshot.Devices = append(snapshot.Devices, deviceSnapshot{
+			DeviceId: device.ID,
+			ModelId:  device.Model.ID,
+			Variant:  device.Variant,
+			Instance: device.Instance,
+		})
+	}
+
+	return json.Marshal(snapshot)
+}
+
+func (s *snapshotSerializer) Deserialize(aggregate events.Aggregate, state []byte) error {
+	a, ok := aggregate.(*DatacenterAggregate)
+	if !ok {
+		return events.ErrInvalidAggregate
+	}
+
+	var snapshot datacenterSnapshot
+	if err := json.Unmarshal(state, &snapshot); err != nil {
+		return err
+	}
+
+	a.Datacenter.ID = snapshot.ID
+	a.Datacenter.Site = snapshot.Site
+	a.Datacenter.Building = snapshot.Building
+	a.Datacenter.Room = snapshot.Room
+	a.Datacenter.Providers = snapshot.Providers
+	for _, rackId := range snapshot.RackIds {
+		a.addRack(rackId)
+	}
+	for _, pod := range snapshot.Pods {
+		a.addPod(pod.PodId, pod.Function)
+	}
+	for _, device := range snapshot.Devices {
+		a.addDevice(device.DeviceId, device.ModelId, device.Variant, device.Instance)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package datacenterAggregate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	a := NewDatacenterAggregateWithId("dc1")
+
+	steps := []func() error{
+		func() error {
+			return a.CreateDatacenter(ctx, "DAL1", "b1", "r1", map[string]string{"zayo": "100Gb", "lumen": "10Gb"})
+		},
+		func() error { return a.AddRack(ctx, "r1") },
+		func() error { return a.AddRack(ctx, "r2") },
+		func() error { return a.AddPod(ctx, "p1", datacenter.ComputeFunction) },
+		func() error { return a.AddPod(ctx, "p2", datacenter.ComputeFunction) },
+		func() error { return a.AddPod(ctx, "p3", datacenter.StorageFunction) },
+		func() error { return a.AddDevice(ctx, "d1", "srv", "a", 1) },
+		func() error { return a.AddDevice(ctx, "d2", "srv", "b", 0) },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	serializer := NewSnapshotSerializer()
+	state, err := serializer.Serialize(a)
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	restored := NewDatacenterAggregateWithId("dc1")
+	if err = serializer.Deserialize(restored, state); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+
+	if !reflect.DeepEqual(a.Datacenter, restored.Datacenter) {
+		t.Fatalf("expected the restored datacenter to equal the datacenter\n%+v\ngot\n%+v", a.Datacenter, restored.Datacenter)
+	}
+	// the pods of a function are still counted after a restore.
+	if n := restored.Datacenter.NumPodInstances(datacenter.ComputeFunction); n != 2 {
+		t.Fatalf("expected 2 compute pods, got %d", n)
+	}
+}
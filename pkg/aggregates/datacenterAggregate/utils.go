@@ -5,7 +5,6 @@ import (
 	"errors"
 	"strings"
 
-	"github.com/EventStore/EventStore-Client-Go/esdb"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
 )
 
@@ -17,7 +16,7 @@ func LoadDatacenterAggregate(ctx context.Context, store events.AggregateStore, a
 	datacenter := NewDatacenterAggregateWithId(aggregateId)
 
 	err := store.Exists(ctx, datacenter.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	if err != nil && !errors.Is(err, events.ErrAggregateNotFound) {
 		return nil, err
 	}
 
@@ -12,6 +12,11 @@ const DeviceAggregateType events.AggregateType = "device"
 type DeviceAggregate struct {
 	*events.AggregateBase
 	Device *datacenter.Device
+
+	// the id of the template the device was created with, empty for devices created before it was recorded.
+	templateId string
+	// true once the device has been decommissioned. a decommissioned device can't be changed.
+	decommissioned bool
 }
 
 func NewDeviceAggregateWithId(id string) *DeviceAggregate {
@@ -38,6 +43,12 @@ func (a *DeviceAggregate) When(event events.Event) error {
 	switch event.GetEventType() {
 	case eventsv1.DeviceCreated:
 		return a.onCreate(event)
+	case eventsv1.DeviceRemoved:
+		return a.onRemove(event)
+	case eventsv1.DeviceMoved:
+		return a.onMove(event)
+	case eventsv1.DeviceDecommissioned:
+		return a.onDecommission(event)
 	default:
 		return events.ErrInvalidEventType
 	}
@@ -66,6 +77,47 @@ func (a *DeviceAggregate) onCreate(event events.Event) error {
 	a.Device.Rack.ID = data.RackId
 
 	a.Device.Model = hardware.HardwareModel{ID: data.ModelId}
+	a.templateId = data.TemplateId
+
+	return nil
+}
+
+// TemplateId returns the id of the template the device was created with, or an empty string if the device was
+// created before it was recorded.
+func (a *DeviceAggregate) TemplateId() string {
+	return a.templateId
+}
+
+func (a *DeviceAggregate) onMove(event events.Event) error {
+	var data eventsv1.DeviceMovedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	a.Device.Rack = datacenter.NewRack()
+	a.Device.Rack.ID = data.RackId
+	a.Device.Elevation = data.Elevation
+	a.Device.Hostname = data.Hostname
+	return nil
+}
+
+func (a *DeviceAggregate) onRemove(event events.Event) error {
+	a.Device.Rack = nil
+	a.Device.Elevation = 0
+	return nil
+}
+
+// IsRacked returns true if the device is racked.
+func (a *DeviceAggregate) IsRacked() bool {
+	return a.Device.Rack != nil
+}
 
+func (a *DeviceAggregate) onDecommission(event events.Event) error {
+	a.decommissioned = true
 	return nil
 }
+
+// IsDecommissioned returns true if the device has been decommissioned.
+func (a *DeviceAggregate) IsDecommissioned() bool {
+	return a.decommissioned
+}
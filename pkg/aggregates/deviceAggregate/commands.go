@@ -2,52 +2,194 @@ package deviceAggregate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
 )
 
-func (a *DeviceAggregate) CreateDevice(ctx context.Context, template *datacenter.DeviceTemplate, dc *datacenter.Datacenter, rack *datacenter.Rack, pod *datacenter.Pod, elevation int, cluster int, designation string) error {
-	// get current number of relevant instances for device
-	n := dc.NumDeviceInstances(template.Model.PID, template.Variant)
-	// add 1 to account for adding this device
-	n += 1
+// CreateDevice creates a device of the template in the rack at the elevation, or at the elevation the placement places
+// it at if the elevation is 0. the placement of the template is used if placement is empty.
+func (a *DeviceAggregate) CreateDevice(ctx context.Context, template *datacenter.DeviceTemplate, dc *datacenter.Datacenter, rack *datacenter.Rack, pod *datacenter.Pod, elevation int, cluster int, designation string, placement string) error {
+	deviceId := GetDeviceAggregateId(a.GetId())
+	// a device added to the datacenter by an earlier attempt to create it keeps the instance it was added under.
+	n, added := dc.DeviceInstance(deviceId)
+	if !added {
+		// get current number of relevant instances for device
+		n = dc.NumDeviceInstances(template.Model.ID, template.Variant)
+		// add 1 to account for adding this device
+		n += 1
+	}
+
+	parsedDesignation := datacenter.UnknownDesignation
+	if designation != "" {
+		parsedDesignation = datacenter.ParseDesignation(designation)
+		if parsedDesignation == datacenter.UnknownDesignation {
+			return fmt.Errorf("%w designation: {%s}", ErrInvalidDesignationSpecified, designation)
+		}
+	}
 
-	if elevation != 0 {
-		if !rack.CanFitDeviceAt(template.Model.FormFactor, elevation) {
+	parsedPlacement := datacenter.UnknownPlacement
+	if placement != "" {
+		parsedPlacement = datacenter.ParsePlacement(placement)
+		if parsedPlacement == datacenter.UnknownPlacement {
+			return fmt.Errorf("%w {%s}", ErrInvalidPlacement, placement)
+		}
+	}
+
+	if racked, ok := rack.GetDevice(deviceId); ok {
+		// racked by an earlier attempt to create the device.
+		elevation = racked.Elevation
+	} else if elevation != 0 {
+		if !rack.CanFitDeviceAt(template.Model, elevation) {
+			if err := rack.FitError(template.Model, template.Categories, elevation); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+				return err
+			}
 			return fmt.Errorf("%w {%s}, elevation {%d}, formFactor: {%d}", ErrCantFitDeviceInRack, rack.Name, elevation, template.Model.FormFactor)
 		}
 	} else {
-		el, canFit := rack.CanFitDevice(template.Model.FormFactor)
+		// the device as it is racked, its designation and cluster pair it with another device.
+		device := datacenter.NewDevice()
+		device.ID = deviceId
+		device.Model = template.Model
+		device.Categories = template.Categories
+		device.Designation = parsedDesignation
+		device.Cluster = cluster
+
+		el, canFit := rack.FindPlacement(device, template.PlacementStrategy(parsedPlacement))
 		if !canFit {
+			if err := rack.FitError(template.Model, template.Categories, 0); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+				return err
+			}
 			return fmt.Errorf("%w {%s}, formFactor: {%d}", ErrCantFitDeviceInRack, rack.Name, template.Model.FormFactor)
 		}
 		elevation = el
 	}
 
-	parsedDesignation := datacenter.UnknownDesignation
-	if designation != "" {
-		parsedDesignation = datacenter.ParseDesignation(designation)
-		if parsedDesignation == datacenter.UnknownDesignation {
-			return fmt.Errorf("%w designation: {%s}", ErrInvalidDesignationSpecified, designation)
-		}
+	function, podInstance, err := podFunction(template, pod)
+	if err != nil {
+		return err
 	}
-
 	var podId string
 	if pod != nil {
 		podId = pod.ID
-		if template.Function != datacenter.UnknownFunction && pod.Function != template.Function {
-			return fmt.Errorf("%w: pod function does not match function specified by template. pod: {%s}, template {%s}", ErrFunctionConflict, pod.Function, template.Function)
-		}
 	}
 
-	hostname, err := template.TemplateHostname(datacenter.NewHostnameTemplateVars(dc.Site, pod.Function, pod.Instance, rack.Name, parsedDesignation, elevation, n))
+	hostname, err := template.TemplateHostname(datacenter.NewHostnameTemplateVars(dc.Site, function, podInstance, rack.Name, parsedDesignation, elevation, n))
 	if err != nil {
 		return fmt.Errorf("TemplateHostname: %w", err)
 	}
 
-	event, err := eventsv1.NewDeviceCreatedEvent(a, hostname, elevation, parsedDesignation, cluster, n, template.Model.ID, template.Categories, podId, rack.ID)
+	event, err := eventsv1.NewDeviceCreatedEvent(a, hostname, elevation, parsedDesignation, cluster, n, template.Model.ID, template.Categories, podId, rack.ID, template.ID)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// Move records that the device was moved to the elevation of the rack, the racks record the move of its RU(s). the
+// hostname is rendered again if it depends on the rack or elevation of the device. a device can't be moved to another
+// datacenter, since its pod and instance belong to its datacenter. the device must fit at the elevation, the RU(s) it
+// is moved to can't be reserved for other categories and it can't push the rack over its load ratings, or over its
+// power budget if the rack rejects such devices. the rack may already record the device at the elevation.
+func (a *DeviceAggregate) Move(ctx context.Context, template *datacenter.DeviceTemplate, dc *datacenter.Datacenter, rack *datacenter.Rack, pod *datacenter.Pod, elevation int) error {
+	if a.decommissioned {
+		return fmt.Errorf("%w {%s}", ErrDeviceDecommissioned, a.Device.ID)
+	}
+	if rack.Datacenter == nil || rack.Datacenter.ID != dc.ID {
+		return fmt.Errorf("%w: rack {%s} is not in datacenter {%s}", ErrDatacenterConflict, rack.ID, dc.ID)
+	}
+	if !dc.HasDevice(a.Device.ID) {
+		return fmt.Errorf("%w: device {%s} is not in the datacenter of rack {%s}", ErrDatacenterConflict, a.Device.ID, rack.ID)
+	}
+	if pod != nil && pod.Datacenter != nil && pod.Datacenter.ID != dc.ID {
+		return fmt.Errorf("%w: pod {%s} of device {%s} is not in the datacenter of rack {%s}", ErrDatacenterConflict, pod.ID, a.Device.ID, rack.ID)
+	}
+
+	moved, racked := rack.GetDevice(a.Device.ID)
+	if !racked {
+		moved = datacenter.NewDevice()
+		moved.ID = a.Device.ID
+		moved.Model = template.Model
+		moved.Categories = template.Categories
+	}
+	if err := rack.CheckMove(moved, elevation); err != nil {
+		if !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+			return err
+		}
+		return fmt.Errorf("%w {%s}, elevation {%d}, formFactor: {%d}", ErrCantFitDeviceInRack, rack.Name, elevation, moved.Model.FormFactor)
+	}
+
+	function, podInstance, err := podFunction(template, pod)
+	if err != nil {
+		return err
+	}
+
+	hostname := a.Device.Hostname
+	if template.HostnameDependsOnPlacement() {
+		hostname, err = template.TemplateHostname(datacenter.NewHostnameTemplateVars(dc.Site, function, podInstance, rack.Name, a.Device.Designation, elevation, a.Device.Instance))
+		if err != nil {
+			return fmt.Errorf("TemplateHostname: %w", err)
+		}
+	}
+
+	var fromRackId string
+	if a.IsRacked() {
+		fromRackId = a.Device.Rack.ID
+	}
+
+	event, err := eventsv1.NewDeviceMovedEvent(a, fromRackId, a.Device.Elevation, rack.ID, elevation, hostname)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// podFunction returns the function and instance of the pod a device created with the template is in, or the function
+// of the template and -1 for a device outside a pod, since it can't be named by a template that references the pod.
+// ErrFunctionConflict is returned if the template's function doesn't match the pod's.
+func podFunction(template *datacenter.DeviceTemplate, pod *datacenter.Pod) (datacenter.Function, int, error) {
+	if pod == nil {
+		return template.Function, -1, nil
+	}
+	if template.Function != datacenter.UnknownFunction && pod.Function != template.Function {
+		return datacenter.UnknownFunction, 0, fmt.Errorf("%w: pod function does not match function specified by template. pod: {%s}, template {%s}", ErrFunctionConflict, pod.Function, template.Function)
+	}
+	return pod.Function, pod.Instance, nil
+}
+
+// RemoveFromRack records that the device was unracked, see rackAggregate.RackAggregate.RemoveDevice. the reason is
+// recorded with the event.
+func (a *DeviceAggregate) RemoveFromRack(ctx context.Context, reason string) error {
+	if a.decommissioned {
+		return fmt.Errorf("%w {%s}", ErrDeviceDecommissioned, a.Device.ID)
+	}
+	if !a.IsRacked() {
+		return fmt.Errorf("%w {%s}", ErrDeviceNotRacked, a.Device.ID)
+	}
+
+	event, err := eventsv1.NewDeviceRemovedEvent(a, a.Device.Rack.ID, reason)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// Decommission retires an unracked device. the reason is recorded with the event. the instance of a decommissioned device is
+// not reused by the devices created after it, so their hostnames never collide with its history.
+func (a *DeviceAggregate) Decommission(ctx context.Context, reason string) error {
+	if a.decommissioned {
+		return fmt.Errorf("%w {%s}", ErrDeviceDecommissioned, a.Device.ID)
+	}
+	if a.IsRacked() {
+		return fmt.Errorf("%w {%s} in rack {%s}", ErrDeviceRacked, a.Device.ID, a.Device.Rack.ID)
+	}
+
+	event, err := eventsv1.NewDeviceDecommissionedEvent(a, reason)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,258 @@
+package deviceAggregate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// moveFixture is a 2U device racked at elevation 10 of the rack a01 of dal1, with the rack a02 next to it and the rack
+// b01 in sjc1.
+type moveFixture struct {
+	dc       *datacenter.Datacenter
+	racks    map[string]*rackAggregate.RackAggregate
+	template *datacenter.DeviceTemplate
+	device   *DeviceAggregate
+}
+
+func newMoveFixture(t *testing.T, hostnameTemplate string) *moveFixture {
+	t.Helper()
+	ctx := context.Background()
+
+	weight, err := units.ParseMass("20kg")
+	if err != nil {
+		t.Fatalf("ParseMass: %v", err)
+	}
+	template := datacenter.NewDeviceTemplate()
+	template.ID = "t1"
+	template.Categories = []string{"network"}
+	template.HostnameTemplate = hostnameTemplate
+	template.Model = hardware.HardwareModel{ID: "sw", FormFactor: 2, Weight: weight, Power: hardware.PowerDraw{Typical: 300, Max: 500, PSUs: 2}}
+
+	dc := datacenterAggregate.NewDatacenterAggregateWithId("dal1")
+	if err = dc.CreateDatacenter(ctx, "dal1", "", "", nil); err != nil {
+		t.Fatalf("CreateDatacenter: %v", err)
+	}
+
+	racks := make(map[string]*rackAggregate.RackAggregate)
+	for id, datacenterId := range map[string]string{"a01": "dal1", "a02": "dal1", "b01": "sjc1"} {
+		rack := rackAggregate.NewRackAggregateWithId(id)
+		if err = rack.CreateRack(ctx, id, 42, datacenterId); err != nil {
+			t.Fatalf("CreateRack: %v", err)
+		}
+		racks[id] = rack
+	}
+
+	device := NewDeviceAggregateWithId("d1")
+	if err = device.CreateDevice(ctx, template, dc.Datacenter, racks["a01"].Rack, nil, 10, 0, "", ""); err != nil {
+		t.Fatalf("CreateDevice: %v", err)
+	}
+	if err = racks["a01"].AddDevice(ctx, racking(template), 10); err != nil {
+		t.Fatalf("AddDevice: %v", err)
+	}
+	if err = dc.AddDevice(ctx, "d1", template.Model.ID, template.Variant, device.Device.Instance); err != nil {
+		t.Fatalf("AddDevice: %v", err)
+	}
+
+	return &moveFixture{dc: dc.Datacenter, racks: racks, template: template, device: device}
+}
+
+// racking returns the device d1 as it is racked.
+func racking(template *datacenter.DeviceTemplate) *datacenter.Device {
+	device := datacenter.NewDevice()
+	device.ID = "d1"
+	device.Model = template.Model
+	device.Categories = template.Categories
+	return device
+}
+
+func TestMoveRendersHostname(t *testing.T) {
+	tests := []struct {
+		name             string
+		hostnameTemplate string
+		rackId           string
+		elevation        int
+		expectedHostname string
+	}{
+		{name: "elevation", hostnameTemplate: "{{.Site}}-sw-u{{.Elevation}}", rackId: "a01", elevation: 20, expectedHostname: "DAL1-SW-U20"},
+		{name: "rack", hostnameTemplate: "{{.Site}}-{{.Rack}}-sw", rackId: "a02", elevation: 10, expectedHostname: "DAL1-A02-SW"},
+		{name: "independent of the placement", hostnameTemplate: "{{.Site}}-sw{{.Number}}", rackId: "a02", elevation: 20, expectedHostname: "DAL1-SW01"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := newMoveFixture(t, test.hostnameTemplate)
+			if err := f.device.Move(context.Background(), f.template, f.dc, f.racks[test.rackId].Rack, nil, test.elevation); err != nil {
+				t.Fatalf("Move: %v", err)
+			}
+
+			if f.device.Device.Hostname != test.expectedHostname {
+				t.Fatalf("expected the hostname %s, got %s", test.expectedHostname, f.device.Device.Hostname)
+			}
+			if f.device.Device.Rack.ID != test.rackId || f.device.Device.Elevation != test.elevation {
+				t.Fatalf("expected the device at %s/%d, got %s/%d", test.rackId, test.elevation, f.device.Device.Rack.ID, f.device.Device.Elevation)
+			}
+		})
+	}
+}
+
+func TestMoveWithinRack(t *testing.T) {
+	ctx := context.Background()
+	f := newMoveFixture(t, "{{.Site}}-sw-u{{.Elevation}}")
+
+	// the device occupies 10 and 9, the RU(s) it is shifted to overlap them.
+	if err := f.device.Move(ctx, f.template, f.dc, f.racks["a01"].Rack, nil, 11); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if f.device.Device.Elevation != 11 || f.device.Device.Hostname != "DAL1-SW-U11" {
+		t.Fatalf("expected DAL1-SW-U11 at 11, got %s at %d", f.device.Device.Hostname, f.device.Device.Elevation)
+	}
+}
+
+func TestMoveConstraints(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		prepare   func(t *testing.T, f *moveFixture) *datacenter.Pod
+		rackId    string
+		elevation int
+		expected  error
+	}{
+		{
+			name:      "another datacenter",
+			rackId:    "b01",
+			elevation: 10,
+			expected:  ErrDatacenterConflict,
+		},
+		{
+			name: "pod of another datacenter",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				pod := datacenter.NewPod()
+				pod.ID = "p1"
+				pod.Datacenter = datacenter.NewDatacenter()
+				pod.Datacenter.ID = "sjc1"
+				return pod
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  ErrDatacenterConflict,
+		},
+		{
+			name: "function of the pod",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				f.template.Function = datacenter.StorageFunction
+				pod := datacenter.NewPod()
+				pod.ID = "p1"
+				pod.Function = datacenter.ComputeFunction
+				return pod
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  ErrFunctionConflict,
+		},
+		{
+			name: "occupied",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				other := racking(f.template)
+				other.ID = "d2"
+				if err := f.racks["a02"].AddDevice(ctx, other, 11); err != nil {
+					t.Fatalf("AddDevice: %v", err)
+				}
+				return nil
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  ErrCantFitDeviceInRack,
+		},
+		{
+			name: "reserved for other categories",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				if err := f.racks["a02"].ReserveRUs(ctx, "9", "patching", []string{"patch-panel"}); err != nil {
+					t.Fatalf("ReserveRUs: %v", err)
+				}
+				return nil
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  datacenter.ErrRUsReserved,
+		},
+		{
+			name: "overloaded",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				if err := f.racks["a02"].ConfigureLoad(ctx, "15kg", "", "", 0); err != nil {
+					t.Fatalf("ConfigureLoad: %v", err)
+				}
+				return nil
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  datacenter.ErrRackOverloaded,
+		},
+		{
+			name: "heavy device too high",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				if err := f.racks["a02"].ConfigureLoad(ctx, "500kg", "", "20kg", 20); err != nil {
+					t.Fatalf("ConfigureLoad: %v", err)
+				}
+				return nil
+			},
+			rackId:    "a02",
+			elevation: 30,
+			expected:  datacenter.ErrHeavyDeviceTooHigh,
+		},
+		{
+			name: "over the power budget",
+			prepare: func(t *testing.T, f *moveFixture) *datacenter.Pod {
+				feeds := []datacenter.PowerFeed{{Name: "A", Phases: 1, Amperage: 1, Voltage: 208}}
+				if err := f.racks["a02"].ConfigurePower(ctx, feeds, 0.8, "reject"); err != nil {
+					t.Fatalf("ConfigurePower: %v", err)
+				}
+				return nil
+			},
+			rackId:    "a02",
+			elevation: 10,
+			expected:  datacenter.ErrPowerBudgetExceeded,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := newMoveFixture(t, "{{.Site}}-sw{{.Number}}")
+			var pod *datacenter.Pod
+			if test.prepare != nil {
+				pod = test.prepare(t, f)
+			}
+
+			err := f.device.Move(ctx, f.template, f.dc, f.racks[test.rackId].Rack, pod, test.elevation)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+			if len(f.device.GetUncommittedEvents()) != 1 {
+				t.Fatalf("expected only the creation of the device, got %d events", len(f.device.GetUncommittedEvents()))
+			}
+		})
+	}
+}
+
+func TestMoveAllowedOverBudgetOrInReservationOfCategory(t *testing.T) {
+	ctx := context.Background()
+	f := newMoveFixture(t, "{{.Site}}-sw{{.Number}}")
+
+	rack := f.racks["a02"]
+	feeds := []datacenter.PowerFeed{{Name: "A", Phases: 1, Amperage: 1, Voltage: 208}}
+	if err := rack.ConfigurePower(ctx, feeds, 0.8, "warn"); err != nil {
+		t.Fatalf("ConfigurePower: %v", err)
+	}
+	if err := rack.ReserveRUs(ctx, "9-10", "switches", []string{"NETWORK"}); err != nil {
+		t.Fatalf("ReserveRUs: %v", err)
+	}
+
+	if err := f.device.Move(ctx, f.template, f.dc, rack.Rack, nil, 10); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+}
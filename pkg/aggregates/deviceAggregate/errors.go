@@ -5,5 +5,11 @@ import "errors"
 var (
 	ErrCantFitDeviceInRack         = errors.New("can't fit device in rack")
 	ErrInvalidDesignationSpecified = errors.New("invalid designation specified")
+	ErrInvalidPlacement            = errors.New("invalid placement")
 	ErrFunctionConflict            = errors.New("function conflict")
+	ErrDeviceDecommissioned        = errors.New("device decommissioned")
+	ErrDeviceNotRacked             = errors.New("device not racked")
+	ErrDeviceRacked                = errors.New("device racked")
+	ErrDatacenterConflict          = errors.New("datacenter conflict")
+	ErrTemplateNotRecorded         = errors.New("device template not recorded")
 )
@@ -55,8 +55,15 @@ func (a *DeviceTemplateAggregate) onCreate(event events.Event) error {
 	a.DeviceTemplate.HostnameTemplate = data.HostnameTemplate
 	a.DeviceTemplate.Alias = data.Alias
 	a.DeviceTemplate.Function = data.Function
+	a.DeviceTemplate.Placement = data.Placement
 
-	a.DeviceTemplate.Model = hardware.HardwareModel{ID: data.ModelId}
+	a.DeviceTemplate.Model = hardware.HardwareModel{
+		ID:         data.ModelId,
+		FormFactor: data.FormFactor,
+		Mounting:   hardware.Mounting{Face: data.Face, Depth: data.Depth, Width: data.Width},
+		Weight:     data.Weight,
+		Power:      hardware.PowerDraw{Typical: data.TypicalPower, Max: data.MaxPower, PSUs: data.PSUs},
+	}
 
 	return nil
 }
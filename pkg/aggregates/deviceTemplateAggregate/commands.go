@@ -6,18 +6,46 @@ import (
 	"strings"
 
 	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
 )
 
-const defaultVariant = "default"
+const (
+	defaultVariant    = "default"
+	defaultFormFactor = 1
+)
 
 var defaultCategories = []string{"x"}
 
-func (a *DeviceTemplateAggregate) CreateDeviceTemplate(ctx context.Context, modelId, variant string, categories []string, hostnameTemplate, alias, function string) error {
+func (a *DeviceTemplateAggregate) CreateDeviceTemplate(ctx context.Context, modelId string, formFactor int, mounting hardware.Mounting, weight string, power hardware.PowerDraw, variant string, categories []string, hostnameTemplate, alias, function, placement string) error {
 	if modelId == "" {
 		return ErrModelIdNotProvided
 	}
 
+	if formFactor < 0 {
+		return fmt.Errorf("%w {%d}", ErrInvalidFormFactor, formFactor)
+	} else if formFactor == 0 {
+		formFactor = defaultFormFactor
+	}
+
+	mounting, err := parseMounting(mounting)
+	if err != nil {
+		return err
+	}
+
+	parsedWeight, err := units.ParseMass(weight)
+	if err != nil {
+		return fmt.Errorf("%w {%s}: %v", ErrInvalidWeight, weight, err)
+	}
+
+	if power.Typical < 0 || power.Max < 0 || power.PSUs < 0 {
+		return fmt.Errorf("%w: typical {%dW}, max {%dW}, psus {%d}", ErrInvalidPowerDraw, power.Typical, power.Max, power.PSUs)
+	}
+	if power.Max > 0 && power.Max < power.Typical {
+		return fmt.Errorf("%w: max {%dW} is less than typical {%dW}", ErrInvalidPowerDraw, power.Max, power.Typical)
+	}
+
 	if variant == "" {
 		variant = defaultVariant
 	} else {
@@ -47,10 +75,40 @@ func (a *DeviceTemplateAggregate) CreateDeviceTemplate(ctx context.Context, mode
 		}
 	}
 
-	event, err := eventsv1.NewDeviceTemplateCreatedEvent(a, modelId, variant, categories, hostnameTemplate, alias, parsedFunction)
+	parsedPlacement := datacenter.UnknownPlacement
+	if placement != "" {
+		parsedPlacement = datacenter.ParsePlacement(placement)
+		if parsedPlacement == datacenter.UnknownPlacement {
+			return fmt.Errorf("%w {%s}", ErrInvalidPlacement, placement)
+		}
+	}
+
+	event, err := eventsv1.NewDeviceTemplateCreatedEvent(a, modelId, formFactor, mounting, parsedWeight, power, variant, categories, hostnameTemplate, alias, parsedFunction, parsedPlacement)
 	if err != nil {
 		return err
 	}
 
 	return a.Apply(event)
 }
+
+// parseMounting parses the face, depth and width of the mounting. a model is mounted on the front, full depth and full
+// width unless it declares otherwise.
+func parseMounting(mounting hardware.Mounting) (hardware.Mounting, error) {
+	parsed := hardware.Mounting{Face: hardware.FrontFace, Depth: hardware.FullDepth, Width: hardware.FullWidth}
+	if mounting.Face != "" {
+		if parsed.Face = hardware.ParseFace(string(mounting.Face)); parsed.Face == hardware.UnknownFace {
+			return parsed, fmt.Errorf("%w: face {%s}", ErrInvalidMounting, mounting.Face)
+		}
+	}
+	if mounting.Depth != "" {
+		if parsed.Depth = hardware.ParseDepth(string(mounting.Depth)); parsed.Depth == hardware.UnknownDepth {
+			return parsed, fmt.Errorf("%w: depth {%s}", ErrInvalidMounting, mounting.Depth)
+		}
+	}
+	if mounting.Width != "" {
+		if parsed.Width = hardware.ParseWidth(string(mounting.Width)); parsed.Width == hardware.UnknownWidth {
+			return parsed, fmt.Errorf("%w: width {%s}", ErrInvalidMounting, mounting.Width)
+		}
+	}
+	return parsed, nil
+}
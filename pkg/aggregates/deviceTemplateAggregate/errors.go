@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrModelIdNotProvided       = errors.New("modelId not provided")
 	ErrInvalidFunctionSpecified = errors.New("invalid function specified")
+	ErrInvalidFormFactor        = errors.New("invalid form factor")
+	ErrInvalidPowerDraw         = errors.New("invalid power draw")
+	ErrInvalidWeight            = errors.New("invalid weight")
+	ErrInvalidMounting          = errors.New("invalid mounting")
+	ErrInvalidPlacement         = errors.New("invalid placement")
 )
@@ -13,6 +13,9 @@ const PodAggregateType events.AggregateType = "pod"
 type PodAggregate struct {
 	*events.AggregateBase
 	Pod *datacenter.Pod
+
+	// true once the pod has been deleted. a deleted pod can't be changed.
+	deleted bool
 }
 
 func NewPodAggregateWithId(id string) *PodAggregate {
@@ -37,6 +40,10 @@ func NewPodAggregate() *PodAggregate {
 
 func (a *PodAggregate) When(event events.Event) error {
 	switch event.GetEventType() {
+	case eventsv1.PodCreated:
+		return a.onCreate(event)
+	case eventsv1.PodDeleted:
+		return a.onDelete(event)
 	default:
 		return events.ErrInvalidEventType
 	}
@@ -57,3 +64,13 @@ func (a *PodAggregate) onCreate(event events.Event) error {
 
 	return nil
 }
+
+func (a *PodAggregate) onDelete(event events.Event) error {
+	a.deleted = true
+	return nil
+}
+
+// IsDeleted returns true if the pod has been deleted.
+func (a *PodAggregate) IsDeleted() bool {
+	return a.deleted
+}
@@ -2,6 +2,7 @@ package podAggregate
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
@@ -28,3 +29,17 @@ func (a *PodAggregate) CreatePod(ctx context.Context, function string, dc *datac
 
 	return a.Apply(event)
 }
+
+// DeletePod deletes the pod. the reason is recorded with the event.
+func (a *PodAggregate) DeletePod(ctx context.Context, reason string) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrPodDeleted, a.Pod.ID)
+	}
+
+	event, err := eventsv1.NewPodDeletedEvent(a, reason)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrFunctionNotSpecified     = errors.New("function not specified")
 	ErrInvalidFunctionSpecified = errors.New("invalid function specified")
+	ErrPodDeleted               = errors.New("pod deleted")
 )
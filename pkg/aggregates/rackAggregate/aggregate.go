@@ -7,6 +7,7 @@ import (
 	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
 )
 
 const RackAggregateType events.AggregateType = "rack"
@@ -14,6 +15,9 @@ const RackAggregateType events.AggregateType = "rack"
 type RackAggregate struct {
 	*events.AggregateBase
 	Rack *datacenter.Rack
+
+	// true once the rack has been deleted. a deleted rack can't be changed.
+	deleted bool
 }
 
 func NewRackAggregateWithId(id string) *RackAggregate {
@@ -43,6 +47,18 @@ func (a *RackAggregate) When(event events.Event) error {
 		return a.onCreate(event)
 	case eventsv1.DeviceRacked:
 		return a.onDeviceAdd(event)
+	case eventsv1.DeviceUnracked:
+		return a.onDeviceRemove(event)
+	case eventsv1.RackPowerConfigured:
+		return a.onPowerConfigure(event)
+	case eventsv1.RackLoadConfigured:
+		return a.onLoadConfigure(event)
+	case eventsv1.RackRUsReserved:
+		return a.onRUsReserve(event)
+	case eventsv1.RackRUsReleased:
+		return a.onRUsRelease(event)
+	case eventsv1.RackDeleted:
+		return a.onDelete(event)
 	default:
 		return events.ErrInvalidEventType
 	}
@@ -56,13 +72,82 @@ func (a *RackAggregate) onCreate(event events.Event) error {
 
 	a.Rack.ID = GetRackAggregateId(event.GetAggregateId())
 	a.Rack.Name = data.Name
-	a.Rack.Size = data.Size
+	a.Rack.SetSize(data.Size)
 	a.Rack.Datacenter = datacenter.NewDatacenter()
 	a.Rack.Datacenter.ID = data.DatacenterId
 
 	return nil
 }
 
+func (a *RackAggregate) onPowerConfigure(event events.Event) error {
+	var data eventsv1.RackPowerConfiguredEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	a.Rack.Power = datacenter.RackPower{
+		Feeds:             data.Feeds,
+		DeratingThreshold: data.DeratingThreshold,
+		Policy:            data.Policy,
+	}
+	return nil
+}
+
+func (a *RackAggregate) onLoadConfigure(event events.Event) error {
+	var data eventsv1.RackLoadConfiguredEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	a.Rack.Load = datacenter.RackLoad{
+		StaticRating:            data.StaticRating,
+		DynamicRating:           data.DynamicRating,
+		HeavyDeviceWeight:       data.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: data.HeavyDeviceMaxElevation,
+	}
+	return nil
+}
+
+func (a *RackAggregate) onRUsReserve(event events.Event) error {
+	var data eventsv1.RackRUsReservedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	rus, err := ranges.ParseRange(data.RUs)
+	if err != nil {
+		return err
+	}
+	a.Rack.Reserve(datacenter.Reservation{RUs: rus, Reason: data.Reason, Categories: data.Categories})
+	return nil
+}
+
+func (a *RackAggregate) onRUsRelease(event events.Event) error {
+	var data eventsv1.RackRUsReleasedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	rus, err := ranges.ParseRange(data.RUs)
+	if err != nil {
+		return err
+	}
+	if !a.Rack.Release(rus) {
+		return fmt.Errorf("%w {%s}", ErrRUsNotReserved, data.RUs)
+	}
+	return nil
+}
+
+func (a *RackAggregate) onDelete(event events.Event) error {
+	a.deleted = true
+	return nil
+}
+
+// IsDeleted returns true if the rack has been deleted.
+func (a *RackAggregate) IsDeleted() bool {
+	return a.deleted
+}
+
 func (a *RackAggregate) onDeviceAdd(event events.Event) error {
 	var data eventsv1.DeviceRackedEvent
 	if err := event.GetJsonData(&data); err != nil {
@@ -71,17 +156,44 @@ func (a *RackAggregate) onDeviceAdd(event events.Event) error {
 
 	device := datacenter.NewDevice()
 	device.ID = data.DeviceId
-	device.Model = hardware.HardwareModel{FormFactor: data.FormFactor}
+	device.Side = data.Side
+	device.Categories = data.Categories
+	device.Designation = data.Designation
+	device.Cluster = data.Cluster
+	device.Model = hardware.HardwareModel{
+		ID:         data.ModelId,
+		FormFactor: data.FormFactor,
+		Mounting:   hardware.Mounting{Face: data.Face, Depth: data.Depth, Width: data.Width},
+		Weight:     data.Weight,
+		Power:      hardware.PowerDraw{Typical: data.TypicalPower, Max: data.MaxPower, PSUs: data.PSUs},
+	}
+	return a.rackDevice(device, data.Elevation)
+}
+
+func (a *RackAggregate) onDeviceRemove(event events.Event) error {
+	var data eventsv1.DeviceUnrackedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	if _, ok := a.Rack.UnrackDevice(data.DeviceId); !ok {
+		return fmt.Errorf("%w {%s}", ErrDeviceNotRacked, data.DeviceId)
+	}
+	return nil
+}
 
+// rackDevice racks the device in its side. only the id, form factor, mounting, weight and power of its model, its
+// categories, designation and cluster are known to the rack.
+func (a *RackAggregate) rackDevice(device *datacenter.Device, elevation int) error {
 	// if elevation is not specified
-	if data.Elevation == 0 {
+	if elevation == 0 {
 		// rack device at the next available elevation
 		if err := a.Rack.RackDevice(device); err != nil {
 			return fmt.Errorf("RackDevice: error {%w}", err)
 		}
 	} else {
 		// rack device at the specified elevation
-		if err := a.Rack.RackDeviceAt(device, data.Elevation); err != nil {
+		if err := a.Rack.RackDeviceAt(device, elevation); err != nil {
 			return fmt.Errorf("RackDeviceAt: error {%w}", err)
 		}
 	}
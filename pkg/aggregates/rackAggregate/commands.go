@@ -2,9 +2,14 @@ package rackAggregate
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
 	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
 )
 
 const defaultRackSize = 45
@@ -31,16 +36,300 @@ func (a *RackAggregate) CreateRack(ctx context.Context, name string, size int, d
 	return a.Apply(event)
 }
 
-func (a *RackAggregate) AddDevice(ctx context.Context, deviceId string, elevation int, formFactor int) error {
-	if deviceId == "" {
+// AddDevice racks the device, a device of the model of its template, at the elevation, or at the highest range of
+// RU(s) it fits in if the elevation is 0. the weight of the model and the power it draws are recorded with the event
+// so that the rack can check them against its load ratings and its feeds, the categories of the device so that the
+// rack knows the reserved RU(s) it may be moved into, and its designation and cluster so that the rack knows its
+// partner.
+func (a *RackAggregate) AddDevice(ctx context.Context, device *datacenter.Device, elevation int) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if device.ID == "" {
 		return ErrDeviceIDNotProvided
 	}
 
-	if formFactor == 0 {
+	if device.Model.FormFactor == 0 {
 		return ErrDeviceFormFactorNotProvided
 	}
 
-	event, err := eventsv1.NewDeviceRackedEvent(a, deviceId, elevation, formFactor)
+	if _, ok := a.Rack.GetDevice(device.ID); ok {
+		return fmt.Errorf("%w {%s}", ErrDeviceAlreadyRacked, device.ID)
+	}
+
+	// a half width device claims the first free side of the elevation, so that it is racked in the same side when the
+	// event is replayed.
+	var side datacenter.Side
+	if elevation != 0 {
+		side, _ = a.Rack.FreeSide(device.Model, elevation)
+	}
+
+	event, err := eventsv1.NewDeviceRackedEvent(a, device, elevation, side)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// RemoveDevice unracks the device, freeing its RU(s). the reason is recorded with the event.
+func (a *RackAggregate) RemoveDevice(ctx context.Context, deviceId string, reason string) error {
+	if deviceId == "" {
+		return ErrDeviceIDNotProvided
+	}
+
+	device, ok := a.Rack.GetDevice(deviceId)
+	if !ok {
+		return fmt.Errorf("%w {%s} in rack {%s}", ErrDeviceNotRacked, deviceId, a.Rack.ID)
+	}
+
+	event, err := eventsv1.NewDeviceUnrackedEvent(a, deviceId, device.Elevation, device.Model.FormFactor, reason)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// MoveDevice moves a device racked in the rack to the elevation, or to the range of RU(s) outside the RU(s) reserved
+// for other categories the strategy places it in if the elevation is 0, and returns the elevation it was moved to. the
+// RU(s) the device occupies are free for the move. the move is recorded as the device being unracked, then racked
+// again at its new elevation.
+func (a *RackAggregate) MoveDevice(ctx context.Context, deviceId string, elevation int, strategy datacenter.PlacementStrategy, reason string) (int, error) {
+	if a.deleted {
+		return 0, fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	device, ok := a.Rack.GetDevice(deviceId)
+	if !ok {
+		return 0, fmt.Errorf("%w {%s} in rack {%s}", ErrDeviceNotRacked, deviceId, a.Rack.ID)
+	}
+
+	if elevation == 0 {
+		if elevation, ok = a.Rack.FindPlacement(device, strategy); !ok {
+			if err := a.Rack.MoveError(device, 0); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+				return 0, err
+			}
+			return 0, fmt.Errorf("%w: could not find a valid range of RU(s) to move a device of size %d to", datacenter.ErrUnableToFitDevice, device.Model.FormFactor)
+		}
+	} else if !a.Rack.CanMoveDeviceAt(device, elevation) {
+		if err := a.Rack.MoveError(device, elevation); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%w: cannot move a device of size %d to elevation %d", datacenter.ErrUnableToFitDevice, device.Model.FormFactor, elevation)
+	}
+
+	formFactor := device.Model.FormFactor
+	unracked, err := eventsv1.NewDeviceUnrackedEvent(a, deviceId, device.Elevation, formFactor, reason)
+	if err != nil {
+		return 0, err
+	}
+	if err = a.Apply(unracked); err != nil {
+		return 0, err
+	}
+
+	// the device is unracked, the slots it occupied are free.
+	side, _ := a.Rack.FreeSide(device.Model, elevation)
+	racked, err := eventsv1.NewDeviceRackedEvent(a, device, elevation, side)
+	if err != nil {
+		return 0, err
+	}
+	return elevation, a.Apply(racked)
+}
+
+// ConfigurePower sets the power feeds of the rack, the fraction of the capacity of a feed that may be drawn from it and
+// whether devices that push a feed over that budget are rejected or racked with a warning. a threshold of 0 is
+// datacenter.DefaultDeratingThreshold and an empty policy rejects. a rack that rejects devices over its budget can't be
+// configured with feeds its devices are over the budget of.
+func (a *RackAggregate) ConfigurePower(ctx context.Context, feeds []datacenter.PowerFeed, deratingThreshold float64, policy string) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if len(feeds) == 0 {
+		return ErrPowerFeedNotProvided
+	}
+	names := make(map[string]bool)
+	for i := range feeds {
+		feed := &feeds[i]
+		feed.Name = strings.ToUpper(strings.TrimSpace(feed.Name))
+		if feed.Name == "" {
+			return fmt.Errorf("%w: feed %d has no name", ErrInvalidPowerFeed, i+1)
+		}
+		if names[feed.Name] {
+			return fmt.Errorf("%w: feed {%s} is provided more than once", ErrInvalidPowerFeed, feed.Name)
+		}
+		names[feed.Name] = true
+
+		if feed.Phases == 0 {
+			feed.Phases = 1
+		}
+		if feed.Phases != 1 && feed.Phases != 3 {
+			return fmt.Errorf("%w: feed {%s} has %d phases", ErrInvalidPowerFeed, feed.Name, feed.Phases)
+		}
+		if feed.Amperage <= 0 || feed.Voltage <= 0 {
+			return fmt.Errorf("%w: feed {%s} is rated %dA at %dV", ErrInvalidPowerFeed, feed.Name, feed.Amperage, feed.Voltage)
+		}
+	}
+
+	if deratingThreshold == 0 {
+		deratingThreshold = datacenter.DefaultDeratingThreshold
+	}
+	if deratingThreshold < 0 || deratingThreshold > 1 {
+		return fmt.Errorf("%w {%g}", ErrInvalidDeratingThreshold, deratingThreshold)
+	}
+
+	parsedPolicy := datacenter.RejectOverBudget
+	if policy != "" {
+		parsedPolicy = datacenter.ParsePowerPolicy(policy)
+		if parsedPolicy == datacenter.UnknownPowerPolicy {
+			return fmt.Errorf("%w {%s}", ErrInvalidPowerPolicy, policy)
+		}
+	}
+
+	power := datacenter.RackPower{Feeds: feeds, DeratingThreshold: deratingThreshold, Policy: parsedPolicy}
+	if parsedPolicy == datacenter.RejectOverBudget {
+		for _, load := range datacenter.NewPowerReport(power, a.Rack.RackedDevices()).Feeds {
+			if load.OverBudget {
+				return fmt.Errorf("%w: the devices of rack {%s} draw %.0fW of the %.0fW budget of feed {%s}", datacenter.ErrPowerBudgetExceeded, a.Rack.ID, load.Peak, load.Budget, load.Feed)
+			}
+		}
+	}
+
+	event, err := eventsv1.NewRackPowerConfiguredEvent(a, feeds, deratingThreshold, parsedPolicy)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// ConfigureLoad sets the weight the rack is rated to bear standing and rolled, and the weight from which devices are
+// heavy and can't be racked above heavyMaxElevation. the weights are a mass in any unit, e.g. '1000kg' or '2200lb', an
+// empty weight is unrated. a rack can't be configured with ratings its devices already break.
+func (a *RackAggregate) ConfigureLoad(ctx context.Context, staticRating, dynamicRating, heavyWeight string, heavyMaxElevation int) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if staticRating == "" && dynamicRating == "" && heavyWeight == "" {
+		return ErrLoadRatingNotProvided
+	}
+
+	var (
+		load datacenter.RackLoad
+		err  error
+	)
+	if load.StaticRating, err = units.ParseMass(staticRating); err != nil {
+		return fmt.Errorf("%w: static rating {%s}: %v", ErrInvalidLoadRating, staticRating, err)
+	}
+	if load.DynamicRating, err = units.ParseMass(dynamicRating); err != nil {
+		return fmt.Errorf("%w: dynamic rating {%s}: %v", ErrInvalidLoadRating, dynamicRating, err)
+	}
+	if load.HeavyDeviceWeight, err = units.ParseMass(heavyWeight); err != nil {
+		return fmt.Errorf("%w: heavy device weight {%s}: %v", ErrInvalidLoadRating, heavyWeight, err)
+	}
+	if !load.HeavyDeviceWeight.IsZero() && (heavyMaxElevation < 1 || heavyMaxElevation > a.Rack.Size) {
+		return fmt.Errorf("%w: heavy devices can't be racked above elevation {%d} of a rack of %d RU(s)", ErrInvalidLoadRating, heavyMaxElevation, a.Rack.Size)
+	}
+	load.HeavyDeviceMaxElevation = heavyMaxElevation
+
+	if err = a.Rack.CheckRatings(load); err != nil {
+		return err
+	}
+
+	event, err := eventsv1.NewRackLoadConfiguredEvent(a, load)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// ReserveRUs reserves the range of RU(s), e.g. '42-45', for devices that fall under one of the categories, or for no
+// device if there are none. the reason is recorded with the event. devices are never racked in reserved RU(s)
+// automatically unless they are allowed in them, devices already racked there are left in place. RU(s) can't be
+// reserved twice.
+func (a *RackAggregate) ReserveRUs(ctx context.Context, rus string, reason string, categories []string) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if strings.TrimSpace(rus) == "" {
+		return ErrReservedRUsNotProvided
+	}
+	r, err := ranges.ParseRange(strings.TrimSpace(rus))
+	if err != nil {
+		return err
+	}
+
+	var covered int
+	for ru := 1; ru <= a.Rack.Size; ru++ {
+		if r.InRange(ru) {
+			covered++
+		}
+	}
+	if covered == 0 {
+		return fmt.Errorf("%w: range {%s} has no RU(s) in rack {%s} of %d RU(s)", ErrInvalidReservation, rus, a.Rack.ID, a.Rack.Size)
+	}
+	if reserved := a.Rack.ReservedRUs(r); len(reserved) > 0 {
+		return fmt.Errorf("%w {%v} in rack {%s}", ErrRUsAlreadyReserved, reserved, a.Rack.ID)
+	}
+
+	// like the categories of device templates, the categories are case-insensitive.
+	allowed := make([]string, 0, len(categories))
+	for _, category := range categories {
+		if category = strings.TrimSpace(category); category != "" {
+			allowed = append(allowed, strings.ToLower(category))
+		}
+	}
+
+	event, err := eventsv1.NewRackRUsReservedEvent(a, datacenter.Reservation{RUs: r, Reason: reason, Categories: allowed})
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// ReleaseRUs releases the reservation of the range of RU(s), which must be the range the RU(s) were reserved with. the
+// reason is recorded with the event.
+func (a *RackAggregate) ReleaseRUs(ctx context.Context, rus string, reason string) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if strings.TrimSpace(rus) == "" {
+		return ErrReservedRUsNotProvided
+	}
+	r, err := ranges.ParseRange(strings.TrimSpace(rus))
+	if err != nil {
+		return err
+	}
+	if !a.Rack.HasReservation(r) {
+		return fmt.Errorf("%w {%s} in rack {%s}", ErrRUsNotReserved, rus, a.Rack.ID)
+	}
+
+	event, err := eventsv1.NewRackRUsReleasedEvent(a, r.String(), reason)
+	if err != nil {
+		return err
+	}
+
+	return a.Apply(event)
+}
+
+// DeleteRack deletes an empty rack. the reason is recorded with the event.
+func (a *RackAggregate) DeleteRack(ctx context.Context, reason string) error {
+	if a.deleted {
+		return fmt.Errorf("%w {%s}", ErrRackDeleted, a.Rack.ID)
+	}
+
+	if len(a.Rack.RackedDevices()) > 0 {
+		return fmt.Errorf("%w {%s}", ErrRackNotEmpty, a.Rack.ID)
+	}
+
+	event, err := eventsv1.NewRackDeletedEvent(a, reason)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,136 @@
+package rackAggregate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+)
+
+// replay returns the rack the events of the aggregate rebuild.
+func replay(t *testing.T, a *RackAggregate) *RackAggregate {
+	t.Helper()
+
+	replayed := NewRackAggregateWithId(GetRackAggregateId(a.GetId()))
+	for _, event := range a.GetUncommittedEvents() {
+		if err := replayed.RaiseEvent(event); err != nil {
+			t.Fatalf("RaiseEvent: %v", err)
+		}
+	}
+	return replayed
+}
+
+func TestAddDeviceRecordsFace(t *testing.T) {
+	ctx := context.Background()
+	a := NewRackAggregateWithId("r1")
+	if err := a.CreateRack(ctx, "a01", 42, "dc1"); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+
+	devices := []struct {
+		device *datacenter.Device
+		face   hardware.Face
+		side   datacenter.Side
+	}{
+		{device: newDevice(t, "pp1", 1, hardware.Mounting{Depth: hardware.HalfDepth}, "1kg"), face: hardware.FrontFace},
+		{device: newDevice(t, "pdu1", 1, hardware.Mounting{Face: hardware.RearFace, Depth: hardware.HalfDepth}, "2kg"), face: hardware.RearFace},
+		{device: newDevice(t, "hw1", 1, hardware.Mounting{Face: hardware.RearFace, Depth: hardware.HalfDepth, Width: hardware.HalfWidth}, "1kg"), face: hardware.RearFace, side: datacenter.LeftSide},
+	}
+	elevations := []int{42, 42, 41}
+	for i, d := range devices {
+		if err := a.AddDevice(ctx, d.device, elevations[i]); err != nil {
+			t.Fatalf("AddDevice {%s}: %v", d.device.ID, err)
+		}
+
+		evts := a.GetUncommittedEvents()
+		var data eventsv1.DeviceRackedEvent
+		if err := evts[len(evts)-1].GetJsonData(&data); err != nil {
+			t.Fatalf("GetJsonData: %v", err)
+		}
+		if data.Face != d.face || data.Side != d.side {
+			t.Fatalf("expected {%s} on the %s face, %q side, got %s, %q", d.device.ID, d.face, d.side, data.Face, data.Side)
+		}
+	}
+
+	// the patch panel and the PDU share 42 once replayed, in its front left and rear right slots.
+	replayed := replay(t, a)
+	unit := replayed.Rack.Units[41]
+	if unit.IsEmpty() || unit[0] == nil || unit[0].ID != "pp1" || unit[3] == nil || unit[3].ID != "pdu1" {
+		t.Fatalf("expected pp1 on the front and pdu1 on the rear of 42, got %v", unit)
+	}
+	pdu, _ := replayed.Rack.GetDevice("pdu1")
+	if pdu.Model.Mounting.MountedFace() != hardware.RearFace {
+		t.Fatalf("expected pdu1 to be mounted on the rear, got %s", pdu.Model.Mounting.MountedFace())
+	}
+}
+
+func TestRemoveDeviceFreesRUs(t *testing.T) {
+	ctx := context.Background()
+	a := NewRackAggregateWithId("r1")
+	if err := a.CreateRack(ctx, "a01", 42, "dc1"); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	// srv1 fills both faces of 42-41, hw1 and hw2 share the front of 40.
+	halfWidth := hardware.Mounting{Depth: hardware.HalfDepth, Width: hardware.HalfWidth}
+	for _, racked := range []struct {
+		device *datacenter.Device
+		el     int
+	}{
+		{device: newDevice(t, "srv1", 2, hardware.Mounting{}, "20kg"), el: 42},
+		{device: newDevice(t, "hw1", 1, halfWidth, "1kg"), el: 40},
+		{device: newDevice(t, "hw2", 1, halfWidth, "1kg"), el: 40},
+	} {
+		if err := a.AddDevice(ctx, racked.device, racked.el); err != nil {
+			t.Fatalf("AddDevice {%s}: %v", racked.device.ID, err)
+		}
+	}
+
+	if err := a.RemoveDevice(ctx, "srv1", "decommissioned"); err != nil {
+		t.Fatalf("RemoveDevice: %v", err)
+	}
+	if err := a.RemoveDevice(ctx, "hw1", "decommissioned"); err != nil {
+		t.Fatalf("RemoveDevice: %v", err)
+	}
+
+	evts := a.GetUncommittedEvents()
+	var data eventsv1.DeviceUnrackedEvent
+	if err := evts[len(evts)-2].GetJsonData(&data); err != nil {
+		t.Fatalf("GetJsonData: %v", err)
+	}
+	if data.DeviceId != "srv1" || data.Elevation != 42 || data.FormFactor != 2 || data.Reason != "decommissioned" {
+		t.Fatalf("expected srv1 to be unracked from 42-41, got %+v", data)
+	}
+
+	for name, rack := range map[string]*datacenter.Rack{"removed": a.Rack, "replayed": replay(t, a).Rack} {
+		if !rack.Units[41].IsEmpty() || !rack.Units[40].IsEmpty() {
+			t.Fatalf("%s: expected 42-41 to be empty, got %v %v", name, rack.Units[41], rack.Units[40])
+		}
+		unit := rack.Units[39]
+		if unit[0] != nil || unit[1] == nil || unit[1].ID != "hw2" {
+			t.Fatalf("%s: expected only hw2 in the front right of 40, got %v", name, unit)
+		}
+		if _, ok := rack.GetDevice("srv1"); ok {
+			t.Fatalf("%s: expected srv1 not to be racked", name)
+		}
+		if weight := rack.Weight(); weight != 1 {
+			t.Fatalf("%s: expected the rack to bear 1kg, got %.1fkg", name, weight)
+		}
+		// the freed RU(s) and slots take a device again.
+		if !rack.CanFitDeviceAt(newDevice(t, "srv2", 2, hardware.Mounting{}, "20kg").Model, 42) {
+			t.Fatalf("%s: expected a 2U device to fit at 42", name)
+		}
+		if side, ok := rack.FreeSide(newDevice(t, "hw3", 1, halfWidth, "1kg").Model, 40); !ok || side != datacenter.LeftSide {
+			t.Fatalf("%s: expected the left side of 40 to be free, got %q, %v", name, side, ok)
+		}
+	}
+
+	if err := a.RemoveDevice(ctx, "srv1", ""); !errors.Is(err, ErrDeviceNotRacked) {
+		t.Fatalf("expected %v, got %v", ErrDeviceNotRacked, err)
+	}
+	if err := a.RemoveDevice(ctx, "", ""); !errors.Is(err, ErrDeviceIDNotProvided) {
+		t.Fatalf("expected %v, got %v", ErrDeviceIDNotProvided, err)
+	}
+}
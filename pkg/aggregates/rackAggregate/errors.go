@@ -7,4 +7,18 @@ var (
 	ErrDatacenterIDNotProvided     = errors.New("datacenterId not provided")
 	ErrDeviceIDNotProvided         = errors.New("deviceId not provided")
 	ErrDeviceFormFactorNotProvided = errors.New("device form factor not provided")
+	ErrDeviceAlreadyRacked         = errors.New("device already racked")
+	ErrDeviceNotRacked             = errors.New("device not racked")
+	ErrRackDeleted                 = errors.New("rack deleted")
+	ErrRackNotEmpty                = errors.New("rack not empty")
+	ErrPowerFeedNotProvided        = errors.New("power feed not provided")
+	ErrInvalidPowerFeed            = errors.New("invalid power feed")
+	ErrInvalidDeratingThreshold    = errors.New("invalid derating threshold")
+	ErrInvalidPowerPolicy          = errors.New("invalid power policy")
+	ErrLoadRatingNotProvided       = errors.New("load rating not provided")
+	ErrInvalidLoadRating           = errors.New("invalid load rating")
+	ErrReservedRUsNotProvided      = errors.New("reserved RU(s) not provided")
+	ErrInvalidReservation          = errors.New("invalid reservation")
+	ErrRUsAlreadyReserved          = errors.New("RU(s) already reserved")
+	ErrRUsNotReserved              = errors.New("RU(s) not reserved")
 )
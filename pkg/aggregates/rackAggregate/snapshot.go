@@ -0,0 +1,188 @@
+package rackAggregate
+
+import (
+	"encoding/json"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// snapshotSchemaVersion is the version of rackSnapshot. it must be incremented whenever rackSnapshot changes
+// so that snapshots written with the previous schema are discarded and rebuilt.
+const snapshotSchemaVersion = 7
+
+type rackSnapshot struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Size         int                    `json:"size"`
+	DatacenterId string                 `json:"datacenterId"`
+	Devices      []rackedDeviceSnapshot `json:"devices"`
+	Deleted      bool                   `json:"deleted,omitempty"`
+
+	Feeds             []datacenter.PowerFeed `json:"feeds,omitempty"`
+	DeratingThreshold float64                `json:"deratingThreshold,omitempty"`
+	PowerPolicy       datacenter.PowerPolicy `json:"powerPolicy,omitempty"`
+
+	StaticRating            units.Value `json:"staticRating"`
+	DynamicRating           units.Value `json:"dynamicRating"`
+	HeavyDeviceWeight       units.Value `json:"heavyDeviceWeight"`
+	HeavyDeviceMaxElevation int         `json:"heavyDeviceMaxElevation,omitempty"`
+
+	Reservations []reservationSnapshot `json:"reservations,omitempty"`
+}
+
+type reservationSnapshot struct {
+	RUs        string   `json:"rus"`
+	Reason     string   `json:"reason,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+type rackedDeviceSnapshot struct {
+	DeviceId     string          `json:"deviceId"`
+	Elevation    int             `json:"elevation"`
+	ModelId      string          `json:"modelId,omitempty"`
+	FormFactor   int             `json:"formFactor"`
+	Face         hardware.Face   `json:"face,omitempty"`
+	Depth        hardware.Depth  `json:"depth,omitempty"`
+	Width        hardware.Width  `json:"width,omitempty"`
+	Side         datacenter.Side `json:"side,omitempty"`
+	Weight       units.Value     `json:"weight"`
+	TypicalPower int             `json:"typicalPower,omitempty"`
+	MaxPower     int             `json:"maxPower,omitempty"`
+	PSUs         int             `json:"psus,omitempty"`
+	Categories   []string        `json:"categories,omitempty"`
+
+	Designation datacenter.Designation `json:"designation,omitempty"`
+	Cluster     int                    `json:"cluster,omitempty"`
+}
+
+// snapshotSerializer is the events.SnapshotSerializer for RackAggregate.
+type snapshotSerializer struct{}
+
+func NewSnapshotSerializer() *snapshotSerializer {
+	return &snapshotSerializer{}
+}
+
+func (s *snapshotSerializer) AggregateType() events.AggregateType {
+	return RackAggregateType
+}
+
+func (s *snapshotSerializer) SchemaVersion() int {
+	return snapshotSchemaVersion
+}
+
+func (s *snapshotSerializer) Serialize(aggregate events.Aggregate) ([]byte, error) {
+	a, ok := aggregate.(*RackAggregate)
+	if !ok {
+		return nil, events.ErrInvalidAggregate
+	}
+
+	snapshot := rackSnapshot{
+		ID:      a.Rack.ID,
+		Name:    a.Rack.Name,
+		Size:    a.Rack.Size,
+		Devices: make([]rackedDeviceSnapshot, 0),
+		Deleted: a.deleted,
+
+		Feeds:             a.Rack.Power.Feeds,
+		DeratingThreshold: a.Rack.Power.DeratingThreshold,
+		PowerPolicy:       a.Rack.Power.Policy,
+
+		StaticRating:            a.Rack.Load.StaticRating,
+		DynamicRating:           a.Rack.Load.DynamicRating,
+		HeavyDeviceWeight:       a.Rack.Load.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: a.Rack.Load.HeavyDeviceMaxElevation,
+	}
+	for _, reservation := range a.Rack.Reservations {
+		snapshot.Reservations = append(snapshot.Reservations, reservationSnapshot{
+			RUs:        reservation.RUs.String(),
+			Reason:     reservation.Reason,
+			Categories: reservation.Categories,
+		})
+	}
+	if a.Rack.Datacenter != nil {
+		snapshot.DatacenterId = a.Rack.Datacenter.ID
+	}
+
+	for _, device := range a.Rack.RackedDevices() {
+		snapshot.Devices = append(snapshot.Devices, rackedDeviceSnapshot{
+			DeviceId:     device.ID,
+			Elevation:    device.Elevation,
+			ModelId:      device.Model.ID,
+			FormFactor:   device.Model.FormFactor,
+			Face:         device.Model.Mounting.Face,
+			Depth:        device.Model.Mounting.Depth,
+			Width:        device.Model.Mounting.Width,
+			Side:         device.Side,
+			Weight:       device.Model.Weight,
+			TypicalPower: device.Model.Power.Typical,
+			MaxPower:     device.Model.Power.Max,
+			PSUs:         device.Model.Power.PSUs,
+			Categories:   device.Categories,
+			Designation:  device.Designation,
+			Cluster:      device.Cluster,
+		})
+	}
+
+	return json.Marshal(snapshot)
+}
+
+func (s *snapshotSerializer) Deserialize(aggregate events.Aggregate, state []byte) error {
+	a, ok := aggregate.(*RackAggregate)
+	if !ok {
+		return events.ErrInvalidAggregate
+	}
+
+	var snapshot rackSnapshot
+	if err := json.Unmarshal(state, &snapshot); err != nil {
+		return err
+	}
+
+	a.Rack.ID = snapshot.ID
+	a.Rack.Name = snapshot.Name
+	a.Rack.SetSize(snapshot.Size)
+	a.Rack.Datacenter = datacenter.NewDatacenter()
+	a.Rack.Datacenter.ID = snapshot.DatacenterId
+	a.deleted = snapshot.Deleted
+	a.Rack.Power = datacenter.RackPower{
+		Feeds:             snapshot.Feeds,
+		DeratingThreshold: snapshot.DeratingThreshold,
+		Policy:            snapshot.PowerPolicy,
+	}
+	a.Rack.Load = datacenter.RackLoad{
+		StaticRating:            snapshot.StaticRating,
+		DynamicRating:           snapshot.DynamicRating,
+		HeavyDeviceWeight:       snapshot.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: snapshot.HeavyDeviceMaxElevation,
+	}
+	for _, reservation := range snapshot.Reservations {
+		rus, err := ranges.ParseRange(reservation.RUs)
+		if err != nil {
+			return err
+		}
+		a.Rack.Reserve(datacenter.Reservation{RUs: rus, Reason: reservation.Reason, Categories: reservation.Categories})
+	}
+	for _, racked := range snapshot.Devices {
+		device := datacenter.NewDevice()
+		device.ID = racked.DeviceId
+		device.Side = racked.Side
+		device.Categories = racked.Categories
+		device.Designation = racked.Designation
+		device.Cluster = racked.Cluster
+		device.Model = hardware.HardwareModel{
+			ID:         racked.ModelId,
+			FormFactor: racked.FormFactor,
+			Mounting:   hardware.Mounting{Face: racked.Face, Depth: racked.Depth, Width: racked.Width},
+			Weight:     racked.Weight,
+			Power:      hardware.PowerDraw{Typical: racked.TypicalPower, Max: racked.MaxPower, PSUs: racked.PSUs},
+		}
+		if err := a.rackDevice(device, racked.Elevation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
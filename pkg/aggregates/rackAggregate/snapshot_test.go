@@ -0,0 +1,126 @@
+package rackAggregate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// newDevice returns a device of a model of the form factor, mounting and weight.
+func newDevice(t *testing.T, id string, formFactor int, mounting hardware.Mounting, weight string, categories ...string) *datacenter.Device {
+	t.Helper()
+
+	mass, err := units.ParseMass(weight)
+	if err != nil {
+		t.Fatalf("ParseMass: %v", err)
+	}
+	device := datacenter.NewDevice()
+	device.ID = id
+	device.Categories = categories
+	device.Model = hardware.HardwareModel{
+		ID:         "model-" + id,
+		FormFactor: formFactor,
+		Mounting:   mounting,
+		Weight:     mass,
+		Power:      hardware.PowerDraw{Typical: 200, Max: 400, PSUs: 2},
+	}
+	return device
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	a := NewRackAggregateWithId("r1")
+
+	steps := []func() error{
+		func() error { return a.CreateRack(ctx, "a01", 42, "dc1") },
+		func() error {
+			feeds := []datacenter.PowerFeed{{Name: "A", Amperage: 30, Voltage: 208}, {Name: "B", Amperage: 30, Voltage: 208}}
+			return a.ConfigurePower(ctx, feeds, 0.8, "warn")
+		},
+		func() error { return a.ConfigureLoad(ctx, "1000kg", "800kg", "30kg", 20) },
+		func() error { return a.ReserveRUs(ctx, "40-42", "patching", []string{"network"}) },
+		func() error { return a.ReserveRUs(ctx, "1", "cable management", nil) },
+		func() error { return a.ReleaseRUs(ctx, "1", "no longer needed") },
+		// a 2U server across both faces.
+		func() error {
+			return a.AddDevice(ctx, newDevice(t, "srv1", 2, hardware.Mounting{}, "25kg", "compute"), 10)
+		},
+		// a patch panel on the front and a PDU on the rear of the same RU.
+		func() error {
+			return a.AddDevice(ctx, newDevice(t, "pp1", 1, hardware.Mounting{Depth: hardware.HalfDepth}, "1kg", "network"), 42)
+		},
+		func() error {
+			return a.AddDevice(ctx, newDevice(t, "pdu1", 1, hardware.Mounting{Face: hardware.RearFace, Depth: hardware.HalfDepth}, "2kg"), 42)
+		},
+		// two half width devices side by side.
+		func() error {
+			return a.AddDevice(ctx, newDevice(t, "hw1", 1, hardware.Mounting{Width: hardware.HalfWidth}, "5kg"), 5)
+		},
+		func() error {
+			return a.AddDevice(ctx, newDevice(t, "hw2", 1, hardware.Mounting{Width: hardware.HalfWidth}, "5kg"), 5)
+		},
+		func() error { return a.AddDevice(ctx, newDevice(t, "srv2", 1, hardware.Mounting{}, "10kg"), 0) },
+		func() error { return a.RemoveDevice(ctx, "srv2", "refresh") },
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	serializer := NewSnapshotSerializer()
+	state, err := serializer.Serialize(a)
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	restored := NewRackAggregateWithId("r1")
+	if err = serializer.Deserialize(restored, state); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+
+	if !reflect.DeepEqual(a.Rack, restored.Rack) {
+		t.Fatalf("expected the restored rack to equal the rack\n%+v\ngot\n%+v", a.Rack, restored.Rack)
+	}
+
+	// the units and slots are restored with the devices.
+	for id, elevation := range map[string]int{"srv1": 10, "pp1": 42, "pdu1": 42, "hw1": 5, "hw2": 5} {
+		device, ok := restored.Rack.GetDevice(id)
+		if !ok {
+			t.Fatalf("expected {%s} to be racked", id)
+		}
+		if device.Elevation != elevation {
+			t.Fatalf("expected {%s} at elevation %d, got %d", id, elevation, device.Elevation)
+		}
+	}
+	if _, ok := restored.Rack.GetDevice("srv2"); ok {
+		t.Fatalf("expected {srv2} to be unracked")
+	}
+}
+
+func TestSnapshotRoundTripDeleted(t *testing.T) {
+	ctx := context.Background()
+	a := NewRackAggregateWithId("r1")
+	if err := a.CreateRack(ctx, "a01", 42, "dc1"); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if err := a.DeleteRack(ctx, "decommissioned"); err != nil {
+		t.Fatalf("DeleteRack: %v", err)
+	}
+
+	serializer := NewSnapshotSerializer()
+	state, err := serializer.Serialize(a)
+	if err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+	restored := NewRackAggregateWithId("r1")
+	if err = serializer.Deserialize(restored, state); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+	if !restored.IsDeleted() {
+		t.Fatalf("expected the restored rack to be deleted")
+	}
+}
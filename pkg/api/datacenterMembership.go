@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// v1EventPrefix is the prefix of the type of every V1 event.
+const v1EventPrefix = "V1_"
+
+// DatacenterMembership is a projection of the datacenter the racks, pods and devices are in. it is shared by every
+// event stream of a server, so that a stream can start at the position it resumes from rather than reading the log
+// from its start to learn which aggregates are in its datacenter. the projection reads the log lazily, up to the
+// events it is asked to match.
+type DatacenterMembership struct {
+	mu     sync.Mutex
+	reader events.AllEventsReader
+	// the position of the last event projected.
+	position events.LogPosition
+	// the id of the datacenter of each aggregate in one, indexed by the id of the aggregate's stream.
+	datacenters map[string]string
+	// the id of the datacenter of each rack in one, devices are in a datacenter through their rack.
+	racks map[string]string
+}
+
+func NewDatacenterMembership(reader events.AllEventsReader) *DatacenterMembership {
+	return &DatacenterMembership{
+		reader:      reader,
+		datacenters: make(map[string]string),
+		racks:       make(map[string]string),
+	}
+}
+
+// Match returns true if the event is a V1 event of the datacenter or of an aggregate in it. the event must have been
+// read from the log of the projection's reader.
+func (m *DatacenterMembership) Match(ctx context.Context, datacenterId string, event events.Event) (bool, error) {
+	if !strings.HasPrefix(string(event.GetEventType()), v1EventPrefix) {
+		return false, nil
+	}
+	if event.GetAggregateId() == streamId(datacenterAggregate.DatacenterAggregateType, datacenterId) {
+		return true, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.catchUp(ctx, event.GetLogPosition()); err != nil {
+		return false, err
+	}
+	return m.datacenters[event.GetAggregateId()] == datacenterId, nil
+}
+
+// catchUp projects the log until the event at the passed position has been projected.
+func (m *DatacenterMembership) catchUp(ctx context.Context, to events.LogPosition) error {
+	for before(m.position, to) {
+		batch, err := m.reader.ReadAll(ctx, m.position, tailBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		for _, event := range batch {
+			upcasted, err := events.Upcast(event)
+			if err != nil {
+				return fmt.Errorf("Upcast {%s}: %w", event.GetEventId(), err)
+			}
+			if err = m.project(upcasted); err != nil {
+				return err
+			}
+			m.position = event.GetLogPosition()
+		}
+	}
+	return nil
+}
+
+func (m *DatacenterMembership) project(event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.RackCreated:
+		var data eventsv1.RackCreatedEvent
+		if err := event.GetJsonData(&data); err != nil {
+			return err
+		}
+		m.datacenters[event.GetAggregateId()] = data.DatacenterId
+		m.racks[rackAggregate.GetRackAggregateId(event.GetAggregateId())] = data.DatacenterId
+	case eventsv1.PodCreated:
+		var data eventsv1.PodCreatedEvent
+		if err := event.GetJsonData(&data); err != nil {
+			return err
+		}
+		m.datacenters[event.GetAggregateId()] = data.DatacenterId
+	case eventsv1.DeviceCreated:
+		var data eventsv1.DeviceCreatedEvent
+		if err := event.GetJsonData(&data); err != nil {
+			return err
+		}
+		if datacenterId, ok := m.racks[data.RackId]; ok {
+			m.datacenters[event.GetAggregateId()] = datacenterId
+		}
+	}
+	return nil
+}
+
+// before returns true if the position a comes before the position b in the log.
+func before(a, b events.LogPosition) bool {
+	return a.Commit < b.Commit || (a.Commit == b.Commit && a.Prepare < b.Prepare)
+}
+
+// streamId returns the id of the stream of the aggregate, see events.AggregateBase.SetId.
+func streamId(aggregateType events.AggregateType, aggregateId string) string {
+	return fmt.Sprintf("%s-%s", aggregateType, aggregateId)
+}
@@ -0,0 +1,8 @@
+package grpcapi
+
+import "errors"
+
+var (
+	ErrFilterNotProvided       = errors.New("filter not provided")
+	ErrDatacenterIdNotProvided = errors.New("datacenterId not provided")
+)
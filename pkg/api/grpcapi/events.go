@@ -0,0 +1,44 @@
+package grpcapi
+
+import (
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	grpcapiv1 "github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TailEvents streams the events of the datacenter until the client cancels the call, starting after the requested
+// position. a client that reads slowly slows the tail down rather than buffering events.
+func (s *server) TailEvents(req *grpcapiv1.TailEventsRequest, stream grpcapiv1.DatacenterGenerator_TailEventsServer) error {
+	if req.GetDatacenterId() == "" {
+		return s.toStatus(ErrDatacenterIdNotProvided)
+	}
+
+	ctx := stream.Context()
+	err := api.Tail(ctx, s.reader, events.NewLogPosition(req.GetAfterPosition()), s.opts.pollInterval, func(event events.Event) error {
+		// a position without its prepare position is resumed from approximately, see events.LogPosition.
+		if event.GetPosition() <= req.GetAfterPosition() {
+			return nil
+		}
+		match, err := s.membership.Match(ctx, req.GetDatacenterId(), event)
+		if err != nil || !match {
+			return err
+		}
+		return stream.Send(toEvent(event))
+	}, nil)
+	return s.toStatus(err)
+}
+
+func toEvent(event events.Event) *grpcapiv1.Event {
+	return &grpcapiv1.Event{
+		EventId:       event.GetEventId(),
+		EventType:     string(event.GetEventType()),
+		AggregateType: string(event.GetAggregateType()),
+		AggregateId:   event.GetAggregateId(),
+		Version:       event.GetVersion(),
+		Position:      event.GetPosition(),
+		Timestamp:     timestamppb.New(event.GetTimestamp()),
+		Data:          event.GetData(),
+		Metadata:      event.GetMetadata(),
+	}
+}
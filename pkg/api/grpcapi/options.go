@@ -0,0 +1,29 @@
+package grpcapi
+
+import "time"
+
+const defaultPollInterval = time.Second
+
+type serverOptions struct {
+	// how often TailEvents polls the store for new events.
+	pollInterval time.Duration
+}
+
+type ServerOption func(*serverOptions)
+
+func newServerOptions(opts ...ServerOption) serverOptions {
+	o := serverOptions{pollInterval: defaultPollInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithPollInterval sets how often TailEvents polls the store for new events. (default 1s)
+func WithPollInterval(interval time.Duration) ServerOption {
+	return func(o *serverOptions) {
+		if interval > 0 {
+			o.pollInterval = interval
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	grpcapiv1 "github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) GetDatacenter(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.Datacenter, error) {
+	dc, err := s.repos.Datacenters.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toDatacenter(dc), nil
+}
+
+func (s *server) ListDatacenters(ctx context.Context, req *grpcapiv1.ListDatacentersRequest) (*grpcapiv1.ListDatacentersResponse, error) {
+	dcs, err := s.repos.Datacenters.List(ctx)
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.ListDatacentersResponse{Datacenters: toList(dcs, toDatacenter)}, nil
+}
+
+func (s *server) GetRack(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.Rack, error) {
+	rack, err := s.repos.Racks.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toRack(rack), nil
+}
+
+func (s *server) ListRacks(ctx context.Context, req *grpcapiv1.ListRacksRequest) (*grpcapiv1.ListRacksResponse, error) {
+	if req.GetDatacenterId() == "" {
+		return nil, s.toStatus(ErrDatacenterIdNotProvided)
+	}
+	racks, err := s.repos.Racks.ListByDatacenter(ctx, req.GetDatacenterId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.ListRacksResponse{Racks: toList(racks, toRack)}, nil
+}
+
+func (s *server) GetRackPower(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.RackPowerReport, error) {
+	rack, err := s.repos.Racks.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toRackPowerReport(rack.PowerReport()), nil
+}
+
+func (s *server) GetPod(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.Pod, error) {
+	pod, err := s.repos.Pods.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toPod(pod), nil
+}
+
+func (s *server) ListPods(ctx context.Context, req *grpcapiv1.ListPodsRequest) (*grpcapiv1.ListPodsResponse, error) {
+	if req.GetDatacenterId() == "" {
+		return nil, s.toStatus(ErrDatacenterIdNotProvided)
+	}
+	pods, err := s.repos.Pods.ListByDatacenter(ctx, req.GetDatacenterId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.ListPodsResponse{Pods: toList(pods, toPod)}, nil
+}
+
+func (s *server) GetDevice(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.Device, error) {
+	device, err := s.repos.Devices.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toDevice(device), nil
+}
+
+func (s *server) ListDevices(ctx context.Context, req *grpcapiv1.ListDevicesRequest) (*grpcapiv1.ListDevicesResponse, error) {
+	var (
+		devices []*projections.DeviceProjection
+		err     error
+	)
+	switch filter := req.GetFilter().(type) {
+	case *grpcapiv1.ListDevicesRequest_RackId:
+		devices, err = s.repos.Devices.ListByRack(ctx, filter.RackId)
+	case *grpcapiv1.ListDevicesRequest_PodId:
+		devices, err = s.repos.Devices.ListByPod(ctx, filter.PodId)
+	case *grpcapiv1.ListDevicesRequest_Category:
+		devices, err = s.repos.Devices.ListByCategory(ctx, filter.Category)
+	case *grpcapiv1.ListDevicesRequest_Hostname:
+		var device *projections.DeviceProjection
+		device, err = s.repos.Devices.GetByHostname(ctx, filter.Hostname)
+		devices = []*projections.DeviceProjection{device}
+	default:
+		err = ErrFilterNotProvided
+	}
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.ListDevicesResponse{Devices: toList(devices, toDevice)}, nil
+}
+
+func (s *server) GetDeviceTemplate(ctx context.Context, req *grpcapiv1.GetRequest) (*grpcapiv1.DeviceTemplate, error) {
+	template, err := s.repos.DeviceTemplates.GetById(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return toDeviceTemplate(template), nil
+}
+
+func (s *server) ListDeviceTemplates(ctx context.Context, req *grpcapiv1.ListDeviceTemplatesRequest) (*grpcapiv1.ListDeviceTemplatesResponse, error) {
+	var (
+		templates []*projections.DeviceTemplateProjection
+		err       error
+	)
+	if req.GetCategory() != "" {
+		templates, err = s.repos.DeviceTemplates.ListByCategory(ctx, req.GetCategory())
+	} else {
+		templates, err = s.repos.DeviceTemplates.List(ctx)
+	}
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.ListDeviceTemplatesResponse{DeviceTemplates: toList(templates, toDeviceTemplate)}, nil
+}
+
+func toList[P any, M any](projections []P, to func(P) M) []M {
+	messages := make([]M, 0, len(projections))
+	for _, projection := range projections {
+		messages = append(messages, to(projection))
+	}
+	return messages
+}
+
+func toDatacenter(p *projections.DatacenterProjection) *grpcapiv1.Datacenter {
+	providers := make(map[string]string, len(p.Providers))
+	for provider, speed := range p.Providers {
+		providers[provider] = speed.String()
+	}
+	return &grpcapiv1.Datacenter{
+		Id:        p.ID,
+		Site:      p.Site,
+		Building:  p.Building,
+		Room:      p.Room,
+		Providers: providers,
+		CreatedAt: toTimestamp(p.CreatedAt),
+		UpdatedAt: toTimestamp(p.UpdatedAt),
+	}
+}
+
+func toRack(p *projections.RackProjection) *grpcapiv1.Rack {
+	return &grpcapiv1.Rack{
+		Id:           p.ID,
+		Name:         p.Name,
+		Size:         int32(p.Size),
+		DatacenterId: p.DatacenterId,
+		CreatedAt:    toTimestamp(p.CreatedAt),
+		UpdatedAt:    toTimestamp(p.UpdatedAt),
+
+		Feeds:             toList(p.Feeds, toPowerFeed),
+		DeratingThreshold: p.DeratingThreshold,
+		PowerPolicy:       p.PowerPolicy,
+
+		StaticRating:            p.StaticRating.String(),
+		DynamicRating:           p.DynamicRating.String(),
+		HeavyDeviceWeight:       p.HeavyDeviceWeight.String(),
+		HeavyDeviceMaxElevation: int32(p.HeavyDeviceMaxElevation),
+
+		Reservations: toList(p.Reservations, toReservation),
+	}
+}
+
+func toReservation(r projections.RackReservation) *grpcapiv1.Reservation {
+	return &grpcapiv1.Reservation{
+		Rus:        r.RUs,
+		Reason:     r.Reason,
+		Categories: r.Categories,
+	}
+}
+
+func toPowerFeed(f datacenter.PowerFeed) *grpcapiv1.PowerFeed {
+	return &grpcapiv1.PowerFeed{
+		Name:     f.Name,
+		Phases:   int32(f.Phases),
+		Amperage: int32(f.Amperage),
+		Voltage:  int32(f.Voltage),
+	}
+}
+
+func toRackPowerReport(r datacenter.PowerReport) *grpcapiv1.RackPowerReport {
+	failures := make([]*grpcapiv1.FeedFailure, 0, len(r.Failures))
+	for _, failure := range r.Failures {
+		failures = append(failures, &grpcapiv1.FeedFailure{
+			Failed:    failure.Failed,
+			Feeds:     toList(failure.Feeds, toFeedLoad),
+			Unpowered: failure.Unpowered,
+		})
+	}
+	return &grpcapiv1.RackPowerReport{
+		DeratingThreshold: r.DeratingThreshold,
+		Policy:            string(r.Policy),
+		Feeds:             toList(r.Feeds, toFeedLoad),
+		Failures:          failures,
+	}
+}
+
+func toFeedLoad(l datacenter.FeedLoad) *grpcapiv1.FeedLoad {
+	return &grpcapiv1.FeedLoad{
+		Feed:         l.Feed,
+		Capacity:     l.Capacity,
+		Budget:       l.Budget,
+		Typical:      l.Typical,
+		Peak:         l.Peak,
+		OverBudget:   l.OverBudget,
+		OverCapacity: l.OverCapacity,
+	}
+}
+
+func toPod(p *projections.PodProjection) *grpcapiv1.Pod {
+	return &grpcapiv1.Pod{
+		Id:           p.ID,
+		Name:         p.Name,
+		Function:     p.Function,
+		Instance:     int32(p.Instance),
+		DatacenterId: p.DatacenterId,
+		CreatedAt:    toTimestamp(p.CreatedAt),
+		UpdatedAt:    toTimestamp(p.UpdatedAt),
+	}
+}
+
+func toDevice(p *projections.DeviceProjection) *grpcapiv1.Device {
+	return &grpcapiv1.Device{
+		Id:           p.ID,
+		Hostname:     p.Hostname,
+		Elevation:    int32(p.Elevation),
+		Designation:  p.Designation,
+		Cluster:      int32(p.Cluster),
+		Instance:     int32(p.Instance),
+		Categories:   p.Categories,
+		ModelId:      p.ModelId,
+		PodId:        p.PodId,
+		RackId:       p.RackId,
+		DatacenterId: p.DatacenterId,
+		CreatedAt:    toTimestamp(p.CreatedAt),
+		UpdatedAt:    toTimestamp(p.UpdatedAt),
+	}
+}
+
+func toDeviceTemplate(p *projections.DeviceTemplateProjection) *grpcapiv1.DeviceTemplate {
+	return &grpcapiv1.DeviceTemplate{
+		Id:               p.ID,
+		ModelId:          p.ModelId,
+		FormFactor:       int32(p.FormFactor),
+		Variant:          p.Variant,
+		Function:         p.Function,
+		Categories:       p.Categories,
+		HostnameTemplate: p.HostnameTemplate,
+		Alias:            p.Alias,
+		CreatedAt:        toTimestamp(p.CreatedAt),
+		UpdatedAt:        toTimestamp(p.UpdatedAt),
+		TypicalPower:     int32(p.TypicalPower),
+		MaxPower:         int32(p.MaxPower),
+		Psus:             int32(p.PSUs),
+		Weight:           p.Weight.String(),
+		Face:             p.Face,
+		Depth:            p.Depth,
+		Width:            p.Width,
+		Placement:        p.Placement,
+	}
+}
+
+// toTimestamp returns nil for the zero time, so that unset times are left out of messages.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
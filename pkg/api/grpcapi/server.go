@@ -0,0 +1,173 @@
+package grpcapi
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative v1/generator.proto
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	grpcapiv1 "github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi/v1"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// the metadata keys recorded in the event metadata of the events saved by a command.
+	correlationIdKey = "x-correlation-id"
+	actorKey         = "x-actor"
+	clientVersionKey = "x-client-version"
+)
+
+// server implements the DatacenterGenerator service defined in v1/generator.proto.
+type server struct {
+	grpcapiv1.UnimplementedDatacenterGeneratorServer
+
+	log    logger.Logger
+	bus    events.HandleCommand
+	repos  api.Repositories
+	reader events.AllEventsReader
+	opts   serverOptions
+
+	// the datacenter the aggregates are in, shared by the tails of the events.
+	membership *api.DatacenterMembership
+}
+
+// NewServer returns the DatacenterGenerator service, register it with grpcapiv1.RegisterDatacenterGeneratorServer.
+// commands are handled by the bus (e.g. a commands.Bus with the v1 handlers registered), queries are served from the
+// repositories and events are tailed from the reader.
+func NewServer(log logger.Logger, bus events.HandleCommand, repos api.Repositories, reader events.AllEventsReader, opts ...ServerOption) *server {
+	return &server{
+		log:        log,
+		bus:        bus,
+		repos:      repos,
+		reader:     reader,
+		opts:       newServerOptions(opts...),
+		membership: api.NewDatacenterMembership(reader),
+	}
+}
+
+// command is implemented by the commands of pkg/commands/v1.
+type command interface {
+	events.Command
+	SetCommandId(commandId string)
+}
+
+// handle handles the command with the command id of the request, and with the event metadata found in the
+// metadata of the call.
+func (s *server) handle(ctx context.Context, cmd command, commandId string) (*grpcapiv1.CommandResponse, error) {
+	cmd.SetCommandId(commandId)
+
+	eventMetadata := events.MetadataFromContext(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		eventMetadata.CorrelationId = first(md.Get(correlationIdKey))
+		eventMetadata.Actor = first(md.Get(actorKey))
+		eventMetadata.ClientVersion = first(md.Get(clientVersionKey))
+	}
+
+	if err := s.bus.HandleCommand(events.ContextWithMetadata(ctx, eventMetadata), cmd); err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &grpcapiv1.CommandResponse{AggregateId: cmd.GetAggregateId(), CommandId: cmd.GetCommandId()}, nil
+}
+
+func (s *server) InitDatacenter(ctx context.Context, req *grpcapiv1.InitDatacenterRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewInitDatacenterCommand(req.GetAggregateId(), req.GetSite(), req.GetBuilding(), req.GetRoom(), req.GetProviders())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) CreateRack(ctx context.Context, req *grpcapiv1.CreateRackRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewCreateRackCommand(req.GetAggregateId(), req.GetName(), int(req.GetSize()), req.GetDatacenterId())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) DeleteRack(ctx context.Context, req *grpcapiv1.DeleteRackRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewDeleteRackCommand(req.GetAggregateId(), req.GetReason())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) ConfigureRackPower(ctx context.Context, req *grpcapiv1.ConfigureRackPowerRequest) (*grpcapiv1.CommandResponse, error) {
+	feeds := make([]datacenter.PowerFeed, 0, len(req.GetFeeds()))
+	for _, feed := range req.GetFeeds() {
+		feeds = append(feeds, datacenter.PowerFeed{
+			Name:     feed.GetName(),
+			Phases:   int(feed.GetPhases()),
+			Amperage: int(feed.GetAmperage()),
+			Voltage:  int(feed.GetVoltage()),
+		})
+	}
+	cmd := v1.NewConfigureRackPowerCommand(req.GetAggregateId(), feeds, req.GetDeratingThreshold(), req.GetPolicy())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) ConfigureRackLoad(ctx context.Context, req *grpcapiv1.ConfigureRackLoadRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewConfigureRackLoadCommand(req.GetAggregateId(), req.GetStaticRating(), req.GetDynamicRating(), req.GetHeavyDeviceWeight(), int(req.GetHeavyDeviceMaxElevation()))
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) ReserveRUs(ctx context.Context, req *grpcapiv1.ReserveRUsRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewReserveRUsCommand(req.GetAggregateId(), req.GetRus(), req.GetReason(), req.GetCategories())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) ReleaseRUs(ctx context.Context, req *grpcapiv1.ReleaseRUsRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewReleaseRUsCommand(req.GetAggregateId(), req.GetRus(), req.GetReason())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) DatacenterAddRack(ctx context.Context, req *grpcapiv1.DatacenterAddRackRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewDatacenterAddRackCommand(req.GetAggregateId(), req.GetRackId())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) CreatePod(ctx context.Context, req *grpcapiv1.CreatePodRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewCreatePodCommand(req.GetAggregateId(), req.GetFunction(), req.GetDatacenterId())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) DeletePod(ctx context.Context, req *grpcapiv1.DeletePodRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewDeletePodCommand(req.GetAggregateId(), req.GetReason())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) DatacenterAddPod(ctx context.Context, req *grpcapiv1.DatacenterAddPodRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewDatacenterAddPodCommand(req.GetAggregateId(), req.GetPodId())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) CreateDevice(ctx context.Context, req *grpcapiv1.CreateDeviceRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewCreateDeviceCommand(req.GetAggregateId(), req.GetTemplateId(), int(req.GetElevation()), req.GetRackId(), int(req.GetCluster()), req.GetDesignation(), req.GetPodId(), req.GetPlacement())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) MoveDevice(ctx context.Context, req *grpcapiv1.MoveDeviceRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewMoveDeviceCommand(req.GetAggregateId(), req.GetRackId(), int(req.GetElevation()), req.GetTemplateId(), req.GetPlacement())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) UnrackDevice(ctx context.Context, req *grpcapiv1.UnrackDeviceRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewUnrackDeviceCommand(req.GetAggregateId(), req.GetReason())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) DecommissionDevice(ctx context.Context, req *grpcapiv1.DecommissionDeviceRequest) (*grpcapiv1.CommandResponse, error) {
+	cmd := v1.NewDecommissionDeviceCommand(req.GetAggregateId(), req.GetReason())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func (s *server) CreateDeviceTemplate(ctx context.Context, req *grpcapiv1.CreateDeviceTemplateRequest) (*grpcapiv1.CommandResponse, error) {
+	power := hardware.PowerDraw{Typical: int(req.GetTypicalPower()), Max: int(req.GetMaxPower()), PSUs: int(req.GetPsus())}
+	mounting := hardware.Mounting{Face: hardware.Face(req.GetFace()), Depth: hardware.Depth(req.GetDepth()), Width: hardware.Width(req.GetWidth())}
+	cmd := v1.NewCreateDeviceTemplateCommand(req.GetAggregateId(), req.GetModelId(), int(req.GetFormFactor()), mounting, req.GetWeight(), power, req.GetVariant(), req.GetCategories(), req.GetHostnameTemplate(), req.GetAlias(), req.GetFunction(), req.GetPlacement())
+	return s.handle(ctx, cmd, req.GetCommandId())
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,235 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	grpcapiv1 "github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testServer is the service served over an in-memory connection.
+type testServer struct {
+	client grpcapiv1.DatacenterGeneratorClient
+	// projects the events saved so far into the repositories the queries are served from.
+	runner *projectors.Runner
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+	bus := commands.NewBus(commands.Validation(), commands.Idempotency(log, commands.NewMemoryDedupStore(), time.Minute))
+	if err := v1.RegisterHandlers(bus, store, log); err != nil {
+		t.Fatalf("RegisterHandlers: %v", err)
+	}
+	repos := api.Repositories{
+		Datacenters:     projections.NewMemoryDatacenterRepository(),
+		Racks:           projections.NewMemoryRackRepository(),
+		Pods:            projections.NewMemoryPodRepository(),
+		Devices:         projections.NewMemoryDeviceRepository(),
+		DeviceTemplates: projections.NewMemoryDeviceTemplateRepository(),
+	}
+	runner := projectors.NewRunner(log, store, projectors.NewMemoryCheckpointStore(), []projectors.Projector{
+		projectors.NewDatacenterProjector(repos.Datacenters),
+		projectors.NewRackProjector(repos.Racks),
+	})
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	grpcapiv1.RegisterDatacenterGeneratorServer(srv, NewServer(log, bus, repos, store, WithPollInterval(10*time.Millisecond)))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dial := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dial), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("DialContext: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return &testServer{client: grpcapiv1.NewDatacenterGeneratorClient(conn), runner: runner}
+}
+
+func TestServerCommandRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	resp, err := s.client.InitDatacenter(ctx, &grpcapiv1.InitDatacenterRequest{AggregateId: "dal1", CommandId: "init-dal1", Site: "dal1"})
+	if err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	if resp.GetAggregateId() != "dal1" || resp.GetCommandId() != "init-dal1" {
+		t.Fatalf("unexpected response %v", resp)
+	}
+	// the replayed command is a no-op.
+	if _, err = s.client.InitDatacenter(ctx, &grpcapiv1.InitDatacenterRequest{AggregateId: "dal1", CommandId: "init-dal1", Site: "dal1"}); err != nil {
+		t.Fatalf("replaying InitDatacenter: %v", err)
+	}
+	if _, err = s.client.CreateRack(ctx, &grpcapiv1.CreateRackRequest{AggregateId: "a01", Name: "a01", Size: 42, DatacenterId: "dal1"}); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+
+	if err = s.runner.CatchUp(ctx); err != nil {
+		t.Fatalf("CatchUp: %v", err)
+	}
+	dc, err := s.client.GetDatacenter(ctx, &grpcapiv1.GetRequest{Id: "dal1"})
+	if err != nil {
+		t.Fatalf("GetDatacenter: %v", err)
+	}
+	if dc.GetSite() != "dal1" {
+		t.Fatalf("expected site dal1, got %v", dc)
+	}
+	rack, err := s.client.GetRack(ctx, &grpcapiv1.GetRequest{Id: "a01"})
+	if err != nil {
+		t.Fatalf("GetRack: %v", err)
+	}
+	if rack.GetSize() != 42 {
+		t.Fatalf("expected a 42U rack, got %v", rack)
+	}
+}
+
+func TestServerErrorDetails(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	// the details of a command the datacenter can't handle in its current state.
+	if _, err := s.client.InitDatacenter(ctx, &grpcapiv1.InitDatacenterRequest{AggregateId: "dal1", Site: "dal1"}); err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	if _, err := s.client.CreateRack(ctx, &grpcapiv1.CreateRackRequest{AggregateId: "a01", Name: "a01", DatacenterId: "dal1"}); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if _, err := s.client.DeleteRack(ctx, &grpcapiv1.DeleteRackRequest{AggregateId: "a01", Reason: "test"}); err != nil {
+		t.Fatalf("DeleteRack: %v", err)
+	}
+	_, err := s.client.DeleteRack(ctx, &grpcapiv1.DeleteRackRequest{AggregateId: "a01", Reason: "test"})
+
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected %s, got %v", codes.FailedPrecondition, err)
+	}
+	var (
+		info      *errdetails.ErrorInfo
+		violation *errdetails.PreconditionFailure
+	)
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.PreconditionFailure:
+			violation = d
+		}
+	}
+	if info == nil || info.GetReason() != "RACK_DELETED" || info.GetDomain() != errorDomain {
+		t.Fatalf("expected the RACK_DELETED ErrorInfo, got %v", st.Details())
+	}
+	if violation == nil || len(violation.GetViolations()) != 1 || violation.GetViolations()[0].GetType() != "RACK_DELETED" {
+		t.Fatalf("expected the RACK_DELETED PreconditionFailure, got %v", st.Details())
+	}
+
+	// an invalid argument only carries its ErrorInfo.
+	_, err = recvTail(t, s.client, &grpcapiv1.TailEventsRequest{}, 1)
+	st = status.Convert(err)
+	if st.Code() != codes.InvalidArgument || len(st.Details()) != 1 {
+		t.Fatalf("expected %s with 1 detail, got %v with %v", codes.InvalidArgument, err, st.Details())
+	}
+	if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.GetReason() != "DATACENTER_ID_NOT_PROVIDED" {
+		t.Fatalf("expected the DATACENTER_ID_NOT_PROVIDED ErrorInfo, got %v", st.Details())
+	}
+}
+
+// recvTail tails the events of the request until n events are received.
+func recvTail(t *testing.T, client grpcapiv1.DatacenterGeneratorClient, req *grpcapiv1.TailEventsRequest, n int) ([]*grpcapiv1.Event, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.TailEvents(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	evts := make([]*grpcapiv1.Event, 0, n)
+	for len(evts) < n {
+		event, err := stream.Recv()
+		if err != nil {
+			return evts, err
+		}
+		evts = append(evts, event)
+	}
+	return evts, nil
+}
+
+func TestServerTailEvents(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	// the events of the two datacenters are interleaved.
+	steps := []func() error{
+		func() error {
+			_, err := s.client.InitDatacenter(ctx, &grpcapiv1.InitDatacenterRequest{AggregateId: "dal1", Site: "dal1"})
+			return err
+		},
+		func() error {
+			_, err := s.client.InitDatacenter(ctx, &grpcapiv1.InitDatacenterRequest{AggregateId: "sjc1", Site: "sjc1"})
+			return err
+		},
+		func() error {
+			_, err := s.client.CreateRack(ctx, &grpcapiv1.CreateRackRequest{AggregateId: "b01", Name: "b01", DatacenterId: "sjc1"})
+			return err
+		},
+		func() error {
+			_, err := s.client.CreateRack(ctx, &grpcapiv1.CreateRackRequest{AggregateId: "a01", Name: "a01", DatacenterId: "dal1"})
+			return err
+		},
+		func() error {
+			_, err := s.client.ReserveRUs(ctx, &grpcapiv1.ReserveRUsRequest{AggregateId: "b01", Rus: "1", Reason: "test"})
+			return err
+		},
+		func() error {
+			_, err := s.client.ReserveRUs(ctx, &grpcapiv1.ReserveRUsRequest{AggregateId: "a01", Rus: "1", Reason: "test"})
+			return err
+		},
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+
+	evts, err := recvTail(t, s.client, &grpcapiv1.TailEventsRequest{DatacenterId: "dal1"}, 3)
+	if err != nil {
+		t.Fatalf("TailEvents: %v", err)
+	}
+	for i, id := range []string{"datacenter-dal1", "rack-a01", "rack-a01"} {
+		if evts[i].GetAggregateId() != id {
+			t.Fatalf("expected event %d of {%s}, got {%s} %s", i, id, evts[i].GetAggregateId(), evts[i].GetEventType())
+		}
+	}
+
+	// the rack is still known to be in the datacenter when the tail resumes after its creation.
+	resumed, err := recvTail(t, s.client, &grpcapiv1.TailEventsRequest{DatacenterId: "dal1", AfterPosition: evts[1].GetPosition()}, 1)
+	if err != nil {
+		t.Fatalf("TailEvents: %v", err)
+	}
+	if resumed[0].GetEventId() != evts[2].GetEventId() {
+		t.Fatalf("expected the tail to resume with {%s}, got {%s} %s", evts[2].GetEventId(), resumed[0].GetAggregateId(), resumed[0].GetEventType())
+	}
+}
@@ -0,0 +1,73 @@
+package grpcapi
+
+import (
+	"errors"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// errorDomain is the domain of the google.rpc.ErrorInfo of every error returned by the service.
+const errorDomain = "datacenter-generator"
+
+// toStatus converts err into a status error carrying the Problem that describes it as details.
+func (s *server) toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	problem := classify(err)
+	if problem.Kind == api.Internal {
+		s.log.Errorf("(grpcapi) %v", err)
+	}
+
+	st := status.New(statusCode(problem.Kind), problem.Message)
+	details := []protoiface.MessageV1{&errdetails.ErrorInfo{Reason: problem.Code, Domain: errorDomain}}
+	if problem.Kind == api.FailedPrecondition {
+		details = append(details, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{Type: problem.Code, Description: problem.Message}},
+		})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		s.log.Warnf("(grpcapi) failed to attach the details of {%s}: %v", problem.Code, detailsErr)
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// classify is like api.Classify but also classifies the errors of the transport.
+func classify(err error) api.Problem {
+	switch {
+	case errors.Is(err, ErrFilterNotProvided):
+		return api.Problem{Kind: api.InvalidArgument, Code: "FILTER_NOT_PROVIDED", Message: err.Error()}
+	case errors.Is(err, ErrDatacenterIdNotProvided):
+		return api.Problem{Kind: api.InvalidArgument, Code: "DATACENTER_ID_NOT_PROVIDED", Message: err.Error()}
+	}
+	return api.Classify(err)
+}
+
+func statusCode(kind api.Kind) codes.Code {
+	switch kind {
+	case api.InvalidArgument:
+		return codes.InvalidArgument
+	case api.NotFound:
+		return codes.NotFound
+	case api.AlreadyExists:
+		return codes.AlreadyExists
+	case api.FailedPrecondition:
+		return codes.FailedPrecondition
+	case api.Aborted:
+		return codes.Aborted
+	case api.DeadlineExceeded:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
@@ -0,0 +1,1103 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package grpcapiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// DatacenterGeneratorClient is the client API for DatacenterGenerator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DatacenterGeneratorClient interface {
+	InitDatacenter(ctx context.Context, in *InitDatacenterRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	CreateRack(ctx context.Context, in *CreateRackRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DeleteRack(ctx context.Context, in *DeleteRackRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	ConfigureRackPower(ctx context.Context, in *ConfigureRackPowerRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	ConfigureRackLoad(ctx context.Context, in *ConfigureRackLoadRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	ReserveRUs(ctx context.Context, in *ReserveRUsRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	ReleaseRUs(ctx context.Context, in *ReleaseRUsRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DatacenterAddRack(ctx context.Context, in *DatacenterAddRackRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	CreatePod(ctx context.Context, in *CreatePodRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DeletePod(ctx context.Context, in *DeletePodRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DatacenterAddPod(ctx context.Context, in *DatacenterAddPodRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	MoveDevice(ctx context.Context, in *MoveDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	UnrackDevice(ctx context.Context, in *UnrackDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	DecommissionDevice(ctx context.Context, in *DecommissionDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	CreateDeviceTemplate(ctx context.Context, in *CreateDeviceTemplateRequest, opts ...grpc.CallOption) (*CommandResponse, error)
+	GetDatacenter(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Datacenter, error)
+	ListDatacenters(ctx context.Context, in *ListDatacentersRequest, opts ...grpc.CallOption) (*ListDatacentersResponse, error)
+	GetRack(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Rack, error)
+	ListRacks(ctx context.Context, in *ListRacksRequest, opts ...grpc.CallOption) (*ListRacksResponse, error)
+	GetRackPower(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*RackPowerReport, error)
+	GetPod(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Pod, error)
+	ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
+	GetDevice(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Device, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	GetDeviceTemplate(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*DeviceTemplate, error)
+	ListDeviceTemplates(ctx context.Context, in *ListDeviceTemplatesRequest, opts ...grpc.CallOption) (*ListDeviceTemplatesResponse, error)
+	// TailEvents streams the V1_* events of a datacenter, its racks, pods and devices, as they are saved.
+	TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (DatacenterGenerator_TailEventsClient, error)
+}
+
+type datacenterGeneratorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDatacenterGeneratorClient(cc grpc.ClientConnInterface) DatacenterGeneratorClient {
+	return &datacenterGeneratorClient{cc}
+}
+
+func (c *datacenterGeneratorClient) InitDatacenter(ctx context.Context, in *InitDatacenterRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/InitDatacenter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) CreateRack(ctx context.Context, in *CreateRackRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/CreateRack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) DeleteRack(ctx context.Context, in *DeleteRackRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/DeleteRack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ConfigureRackPower(ctx context.Context, in *ConfigureRackPowerRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ConfigureRackPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ConfigureRackLoad(ctx context.Context, in *ConfigureRackLoadRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ConfigureRackLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ReserveRUs(ctx context.Context, in *ReserveRUsRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ReserveRUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ReleaseRUs(ctx context.Context, in *ReleaseRUsRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ReleaseRUs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) DatacenterAddRack(ctx context.Context, in *DatacenterAddRackRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/DatacenterAddRack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) CreatePod(ctx context.Context, in *CreatePodRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/CreatePod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) DeletePod(ctx context.Context, in *DeletePodRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/DeletePod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) DatacenterAddPod(ctx context.Context, in *DatacenterAddPodRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/DatacenterAddPod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) CreateDevice(ctx context.Context, in *CreateDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/CreateDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) MoveDevice(ctx context.Context, in *MoveDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/MoveDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) UnrackDevice(ctx context.Context, in *UnrackDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/UnrackDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) DecommissionDevice(ctx context.Context, in *DecommissionDeviceRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/DecommissionDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) CreateDeviceTemplate(ctx context.Context, in *CreateDeviceTemplateRequest, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/CreateDeviceTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetDatacenter(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Datacenter, error) {
+	out := new(Datacenter)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetDatacenter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ListDatacenters(ctx context.Context, in *ListDatacentersRequest, opts ...grpc.CallOption) (*ListDatacentersResponse, error) {
+	out := new(ListDatacentersResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ListDatacenters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetRack(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Rack, error) {
+	out := new(Rack)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetRack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ListRacks(ctx context.Context, in *ListRacksRequest, opts ...grpc.CallOption) (*ListRacksResponse, error) {
+	out := new(ListRacksResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ListRacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetRackPower(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*RackPowerReport, error) {
+	out := new(RackPowerReport)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetRackPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetPod(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Pod, error) {
+	out := new(Pod)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetPod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error) {
+	out := new(ListPodsResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ListPods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetDevice(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*Device, error) {
+	out := new(Device)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) GetDeviceTemplate(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*DeviceTemplate, error) {
+	out := new(DeviceTemplate)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/GetDeviceTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) ListDeviceTemplates(ctx context.Context, in *ListDeviceTemplatesRequest, opts ...grpc.CallOption) (*ListDeviceTemplatesResponse, error) {
+	out := new(ListDeviceTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/dcgen.v1.DatacenterGenerator/ListDeviceTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *datacenterGeneratorClient) TailEvents(ctx context.Context, in *TailEventsRequest, opts ...grpc.CallOption) (DatacenterGenerator_TailEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DatacenterGenerator_ServiceDesc.Streams[0], "/dcgen.v1.DatacenterGenerator/TailEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &datacenterGeneratorTailEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DatacenterGenerator_TailEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type datacenterGeneratorTailEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *datacenterGeneratorTailEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DatacenterGeneratorServer is the server API for DatacenterGenerator service.
+// All implementations must embed UnimplementedDatacenterGeneratorServer
+// for forward compatibility
+type DatacenterGeneratorServer interface {
+	InitDatacenter(context.Context, *InitDatacenterRequest) (*CommandResponse, error)
+	CreateRack(context.Context, *CreateRackRequest) (*CommandResponse, error)
+	DeleteRack(context.Context, *DeleteRackRequest) (*CommandResponse, error)
+	ConfigureRackPower(context.Context, *ConfigureRackPowerRequest) (*CommandResponse, error)
+	ConfigureRackLoad(context.Context, *ConfigureRackLoadRequest) (*CommandResponse, error)
+	ReserveRUs(context.Context, *ReserveRUsRequest) (*CommandResponse, error)
+	ReleaseRUs(context.Context, *ReleaseRUsRequest) (*CommandResponse, error)
+	DatacenterAddRack(context.Context, *DatacenterAddRackRequest) (*CommandResponse, error)
+	CreatePod(context.Context, *CreatePodRequest) (*CommandResponse, error)
+	DeletePod(context.Context, *DeletePodRequest) (*CommandResponse, error)
+	DatacenterAddPod(context.Context, *DatacenterAddPodRequest) (*CommandResponse, error)
+	CreateDevice(context.Context, *CreateDeviceRequest) (*CommandResponse, error)
+	MoveDevice(context.Context, *MoveDeviceRequest) (*CommandResponse, error)
+	UnrackDevice(context.Context, *UnrackDeviceRequest) (*CommandResponse, error)
+	DecommissionDevice(context.Context, *DecommissionDeviceRequest) (*CommandResponse, error)
+	CreateDeviceTemplate(context.Context, *CreateDeviceTemplateRequest) (*CommandResponse, error)
+	GetDatacenter(context.Context, *GetRequest) (*Datacenter, error)
+	ListDatacenters(context.Context, *ListDatacentersRequest) (*ListDatacentersResponse, error)
+	GetRack(context.Context, *GetRequest) (*Rack, error)
+	ListRacks(context.Context, *ListRacksRequest) (*ListRacksResponse, error)
+	GetRackPower(context.Context, *GetRequest) (*RackPowerReport, error)
+	GetPod(context.Context, *GetRequest) (*Pod, error)
+	ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
+	GetDevice(context.Context, *GetRequest) (*Device, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	GetDeviceTemplate(context.Context, *GetRequest) (*DeviceTemplate, error)
+	ListDeviceTemplates(context.Context, *ListDeviceTemplatesRequest) (*ListDeviceTemplatesResponse, error)
+	// TailEvents streams the V1_* events of a datacenter, its racks, pods and devices, as they are saved.
+	TailEvents(*TailEventsRequest, DatacenterGenerator_TailEventsServer) error
+	mustEmbedUnimplementedDatacenterGeneratorServer()
+}
+
+// UnimplementedDatacenterGeneratorServer must be embedded to have forward compatible implementations.
+type UnimplementedDatacenterGeneratorServer struct {
+}
+
+func (UnimplementedDatacenterGeneratorServer) InitDatacenter(context.Context, *InitDatacenterRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitDatacenter not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) CreateRack(context.Context, *CreateRackRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRack not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) DeleteRack(context.Context, *DeleteRackRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRack not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ConfigureRackPower(context.Context, *ConfigureRackPowerRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureRackPower not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ConfigureRackLoad(context.Context, *ConfigureRackLoadRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigureRackLoad not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ReserveRUs(context.Context, *ReserveRUsRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveRUs not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ReleaseRUs(context.Context, *ReleaseRUsRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseRUs not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) DatacenterAddRack(context.Context, *DatacenterAddRackRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatacenterAddRack not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) CreatePod(context.Context, *CreatePodRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePod not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) DeletePod(context.Context, *DeletePodRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePod not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) DatacenterAddPod(context.Context, *DatacenterAddPodRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatacenterAddPod not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) CreateDevice(context.Context, *CreateDeviceRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDevice not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) MoveDevice(context.Context, *MoveDeviceRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveDevice not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) UnrackDevice(context.Context, *UnrackDeviceRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrackDevice not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) DecommissionDevice(context.Context, *DecommissionDeviceRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionDevice not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) CreateDeviceTemplate(context.Context, *CreateDeviceTemplateRequest) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeviceTemplate not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetDatacenter(context.Context, *GetRequest) (*Datacenter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatacenter not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ListDatacenters(context.Context, *ListDatacentersRequest) (*ListDatacentersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatacenters not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetRack(context.Context, *GetRequest) (*Rack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRack not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ListRacks(context.Context, *ListRacksRequest) (*ListRacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRacks not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetRackPower(context.Context, *GetRequest) (*RackPowerReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRackPower not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetPod(context.Context, *GetRequest) (*Pod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPod not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPods not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetDevice(context.Context, *GetRequest) (*Device, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) GetDeviceTemplate(context.Context, *GetRequest) (*DeviceTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceTemplate not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) ListDeviceTemplates(context.Context, *ListDeviceTemplatesRequest) (*ListDeviceTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceTemplates not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) TailEvents(*TailEventsRequest, DatacenterGenerator_TailEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TailEvents not implemented")
+}
+func (UnimplementedDatacenterGeneratorServer) mustEmbedUnimplementedDatacenterGeneratorServer() {}
+
+// UnsafeDatacenterGeneratorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DatacenterGeneratorServer will
+// result in compilation errors.
+type UnsafeDatacenterGeneratorServer interface {
+	mustEmbedUnimplementedDatacenterGeneratorServer()
+}
+
+func RegisterDatacenterGeneratorServer(s grpc.ServiceRegistrar, srv DatacenterGeneratorServer) {
+	s.RegisterService(&DatacenterGenerator_ServiceDesc, srv)
+}
+
+func _DatacenterGenerator_InitDatacenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitDatacenterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).InitDatacenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/InitDatacenter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).InitDatacenter(ctx, req.(*InitDatacenterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_CreateRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).CreateRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/CreateRack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).CreateRack(ctx, req.(*CreateRackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_DeleteRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).DeleteRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/DeleteRack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).DeleteRack(ctx, req.(*DeleteRackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ConfigureRackPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRackPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ConfigureRackPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ConfigureRackPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ConfigureRackPower(ctx, req.(*ConfigureRackPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ConfigureRackLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureRackLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ConfigureRackLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ConfigureRackLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ConfigureRackLoad(ctx, req.(*ConfigureRackLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ReserveRUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveRUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ReserveRUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ReserveRUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ReserveRUs(ctx, req.(*ReserveRUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ReleaseRUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ReleaseRUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ReleaseRUs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ReleaseRUs(ctx, req.(*ReleaseRUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_DatacenterAddRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatacenterAddRackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).DatacenterAddRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/DatacenterAddRack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).DatacenterAddRack(ctx, req.(*DatacenterAddRackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_CreatePod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).CreatePod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/CreatePod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).CreatePod(ctx, req.(*CreatePodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_DeletePod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).DeletePod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/DeletePod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).DeletePod(ctx, req.(*DeletePodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_DatacenterAddPod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatacenterAddPodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).DatacenterAddPod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/DatacenterAddPod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).DatacenterAddPod(ctx, req.(*DatacenterAddPodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_CreateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).CreateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/CreateDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).CreateDevice(ctx, req.(*CreateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_MoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).MoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/MoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).MoveDevice(ctx, req.(*MoveDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_UnrackDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnrackDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).UnrackDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/UnrackDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).UnrackDevice(ctx, req.(*UnrackDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_DecommissionDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).DecommissionDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/DecommissionDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).DecommissionDevice(ctx, req.(*DecommissionDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_CreateDeviceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeviceTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).CreateDeviceTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/CreateDeviceTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).CreateDeviceTemplate(ctx, req.(*CreateDeviceTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetDatacenter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetDatacenter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetDatacenter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetDatacenter(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ListDatacenters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatacentersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ListDatacenters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ListDatacenters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ListDatacenters(ctx, req.(*ListDatacentersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetRack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetRack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetRack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetRack(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ListRacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ListRacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ListRacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ListRacks(ctx, req.(*ListRacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetRackPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetRackPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetRackPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetRackPower(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetPod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetPod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetPod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetPod(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ListPods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ListPods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ListPods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ListPods(ctx, req.(*ListPodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetDevice(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_GetDeviceTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).GetDeviceTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/GetDeviceTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).GetDeviceTemplate(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_ListDeviceTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatacenterGeneratorServer).ListDeviceTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dcgen.v1.DatacenterGenerator/ListDeviceTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatacenterGeneratorServer).ListDeviceTemplates(ctx, req.(*ListDeviceTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatacenterGenerator_TailEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DatacenterGeneratorServer).TailEvents(m, &datacenterGeneratorTailEventsServer{stream})
+}
+
+type DatacenterGenerator_TailEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type datacenterGeneratorTailEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *datacenterGeneratorTailEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// DatacenterGenerator_ServiceDesc is the grpc.ServiceDesc for DatacenterGenerator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DatacenterGenerator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dcgen.v1.DatacenterGenerator",
+	HandlerType: (*DatacenterGeneratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InitDatacenter",
+			Handler:    _DatacenterGenerator_InitDatacenter_Handler,
+		},
+		{
+			MethodName: "CreateRack",
+			Handler:    _DatacenterGenerator_CreateRack_Handler,
+		},
+		{
+			MethodName: "DeleteRack",
+			Handler:    _DatacenterGenerator_DeleteRack_Handler,
+		},
+		{
+			MethodName: "ConfigureRackPower",
+			Handler:    _DatacenterGenerator_ConfigureRackPower_Handler,
+		},
+		{
+			MethodName: "ConfigureRackLoad",
+			Handler:    _DatacenterGenerator_ConfigureRackLoad_Handler,
+		},
+		{
+			MethodName: "ReserveRUs",
+			Handler:    _DatacenterGenerator_ReserveRUs_Handler,
+		},
+		{
+			MethodName: "ReleaseRUs",
+			Handler:    _DatacenterGenerator_ReleaseRUs_Handler,
+		},
+		{
+			MethodName: "DatacenterAddRack",
+			Handler:    _DatacenterGenerator_DatacenterAddRack_Handler,
+		},
+		{
+			MethodName: "CreatePod",
+			Handler:    _DatacenterGenerator_CreatePod_Handler,
+		},
+		{
+			MethodName: "DeletePod",
+			Handler:    _DatacenterGenerator_DeletePod_Handler,
+		},
+		{
+			MethodName: "DatacenterAddPod",
+			Handler:    _DatacenterGenerator_DatacenterAddPod_Handler,
+		},
+		{
+			MethodName: "CreateDevice",
+			Handler:    _DatacenterGenerator_CreateDevice_Handler,
+		},
+		{
+			MethodName: "MoveDevice",
+			Handler:    _DatacenterGenerator_MoveDevice_Handler,
+		},
+		{
+			MethodName: "UnrackDevice",
+			Handler:    _DatacenterGenerator_UnrackDevice_Handler,
+		},
+		{
+			MethodName: "DecommissionDevice",
+			Handler:    _DatacenterGenerator_DecommissionDevice_Handler,
+		},
+		{
+			MethodName: "CreateDeviceTemplate",
+			Handler:    _DatacenterGenerator_CreateDeviceTemplate_Handler,
+		},
+		{
+			MethodName: "GetDatacenter",
+			Handler:    _DatacenterGenerator_GetDatacenter_Handler,
+		},
+		{
+			MethodName: "ListDatacenters",
+			Handler:    _DatacenterGenerator_ListDatacenters_Handler,
+		},
+		{
+			MethodName: "GetRack",
+			Handler:    _DatacenterGenerator_GetRack_Handler,
+		},
+		{
+			MethodName: "ListRacks",
+			Handler:    _DatacenterGenerator_ListRacks_Handler,
+		},
+		{
+			MethodName: "GetRackPower",
+			Handler:    _DatacenterGenerator_GetRackPower_Handler,
+		},
+		{
+			MethodName: "GetPod",
+			Handler:    _DatacenterGenerator_GetPod_Handler,
+		},
+		{
+			MethodName: "ListPods",
+			Handler:    _DatacenterGenerator_ListPods_Handler,
+		},
+		{
+			MethodName: "GetDevice",
+			Handler:    _DatacenterGenerator_GetDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _DatacenterGenerator_ListDevices_Handler,
+		},
+		{
+			MethodName: "GetDeviceTemplate",
+			Handler:    _DatacenterGenerator_GetDeviceTemplate_Handler,
+		},
+		{
+			MethodName: "ListDeviceTemplates",
+			Handler:    _DatacenterGenerator_ListDeviceTemplates_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailEvents",
+			Handler:       _DatacenterGenerator_TailEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v1/generator.proto",
+}
@@ -0,0 +1,111 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+const (
+	// the header of the command id, if the body doesn't have one. see events.BaseCommand.CommandId.
+	idempotencyKeyHeader = "Idempotency-Key"
+	correlationIdHeader  = "X-Correlation-Id"
+	actorHeader          = "X-Actor"
+	clientVersionHeader  = "X-Client-Version"
+)
+
+// commandResponse is the body of the response to a command that was handled.
+type commandResponse struct {
+	Command     string `json:"command"`
+	AggregateId string `json:"aggregateId"`
+	CommandId   string `json:"commandId,omitempty"`
+}
+
+// commandMetricsResponse is the body of the metrics of a command type.
+type commandMetricsResponse struct {
+	Command         string `json:"command"`
+	Handled         int    `json:"handled"`
+	Failed          int    `json:"failed"`
+	AverageDuration string `json:"averageDuration"`
+	MaxDuration     string `json:"maxDuration"`
+}
+
+func (s *server) listCommands(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, newListResponse(s.bus.CommandNames()))
+}
+
+// handleCommand decodes the body into the command named name and handles it. the fields of the body are the fields
+// of the command, e.g. {"aggregateId": "a01", "name": "a01", "size": 42, "datacenterId": "dal1"} for a
+// CreateRackCommand.
+func (s *server) handleCommand(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmd, ok := s.bus.NewCommand(name)
+		if !ok {
+			s.writeError(w, r, fmt.Errorf("%w {%s}", events.ErrInvalidCommandType, name))
+			return
+		}
+
+		decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.opts.maxBodyBytes))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(cmd); err != nil && !errors.Is(err, io.EOF) {
+			s.writeError(w, r, fmt.Errorf("%w: %v", ErrMalformedBody, err))
+			return
+		}
+
+		if key := r.Header.Get(idempotencyKeyHeader); key != "" && cmd.GetCommandId() == "" {
+			if c, ok := cmd.(interface{ SetCommandId(string) }); ok {
+				c.SetCommandId(key)
+			}
+		}
+
+		metadata := events.MetadataFromContext(r.Context())
+		metadata.CorrelationId = r.Header.Get(correlationIdHeader)
+		metadata.Actor = r.Header.Get(actorHeader)
+		metadata.ClientVersion = r.Header.Get(clientVersionHeader)
+		ctx := events.ContextWithMetadata(r.Context(), metadata)
+
+		if err := s.bus.HandleCommand(ctx, cmd); err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		s.writeJSON(w, http.StatusAccepted, commandResponse{
+			Command:     name,
+			AggregateId: cmd.GetAggregateId(),
+			CommandId:   cmd.GetCommandId(),
+		})
+	}
+}
+
+// getMetrics lists the metrics of every command type handled since the server started, ordered by command name.
+func (s *server) getMetrics(w http.ResponseWriter, r *http.Request) {
+	snapshot := s.opts.metrics.Snapshot()
+	names := make([]string, 0, len(snapshot))
+	for name := range snapshot {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]commandMetricsResponse, 0, len(names))
+	for _, name := range names {
+		metrics := snapshot[name]
+		var average time.Duration
+		if metrics.Handled > 0 {
+			average = metrics.TotalDuration / time.Duration(metrics.Handled)
+		}
+		items = append(items, commandMetricsResponse{
+			Command:         name,
+			Handled:         metrics.Handled,
+			Failed:          metrics.Failed,
+			AverageDuration: average.String(),
+			MaxDuration:     metrics.MaxDuration.String(),
+		})
+	}
+	s.writeJSON(w, http.StatusOK, newListResponse(items))
+}
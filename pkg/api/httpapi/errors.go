@@ -0,0 +1,15 @@
+package httpapi
+
+import "errors"
+
+var (
+	ErrRouteNotFound    = errors.New("route not found")
+	ErrMethodNotAllowed = errors.New("method not allowed")
+	ErrMalformedBody    = errors.New("malformed body")
+	ErrMissingQuery     = errors.New("missing query parameter")
+
+	ErrMalformedEventId     = errors.New("malformed event id")
+	ErrResumeEventNotFound  = errors.New("event to resume after not found")
+	ErrSlowConsumer         = errors.New("slow consumer")
+	ErrStreamingUnsupported = errors.New("streaming unsupported")
+)
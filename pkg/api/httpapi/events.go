@@ -0,0 +1,365 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// the header a server-sent event client sends the id of the last event it received in when it reconnects.
+	lastEventIdHeader = "Last-Event-ID"
+	// the query parameter to resume after an event with, for clients that can't set headers.
+	lastEventIdQuery = "lastEventId"
+	// the query parameter that restricts a stream to the events of some aggregate types, it may be repeated or hold a
+	// comma separated list.
+	aggregateTypeQuery = "aggregateType"
+)
+
+// streamedEvent is the representation of an event in an event stream.
+type streamedEvent struct {
+	// the id to resume the stream after this event with, see eventStreamId.
+	Id            string          `json:"id"`
+	EventId       string          `json:"eventId"`
+	EventType     string          `json:"eventType"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateId   string          `json:"aggregateId"`
+	Version       int64           `json:"version"`
+	Position      uint64          `json:"position"`
+	Timestamp     time.Time       `json:"timestamp"`
+	Data          json.RawMessage `json:"data,omitempty"`
+	Metadata      json.RawMessage `json:"metadata,omitempty"`
+}
+
+func newStreamedEvent(event events.Event) streamedEvent {
+	return streamedEvent{
+		Id:            eventStreamId{aggregateId: event.GetAggregateId(), version: event.GetVersion()}.String(),
+		EventId:       event.GetEventId(),
+		EventType:     string(event.GetEventType()),
+		AggregateType: string(event.GetAggregateType()),
+		AggregateId:   event.GetAggregateId(),
+		Version:       event.GetVersion(),
+		Position:      event.GetPosition(),
+		Timestamp:     event.GetTimestamp(),
+		Data:          event.GetData(),
+		Metadata:      event.GetMetadata(),
+	}
+}
+
+// webSocketMessage is the body of every message sent over a WebSocket event stream, it holds either an event or the
+// problem that ended the stream.
+type webSocketMessage struct {
+	Event *streamedEvent `json:"event,omitempty"`
+	Error *api.Problem   `json:"error,omitempty"`
+}
+
+// eventStreamId identifies an event by the stream of its aggregate and its version within it, e.g. 'rack-a01/3'.
+type eventStreamId struct {
+	aggregateId string
+	version     int64
+}
+
+func parseEventStreamId(s string) (eventStreamId, error) {
+	i := strings.LastIndex(s, "/")
+	if i <= 0 {
+		return eventStreamId{}, fmt.Errorf("%w {%s}", ErrMalformedEventId, s)
+	}
+	version, err := strconv.ParseInt(s[i+1:], 10, 64)
+	if err != nil || version < 0 {
+		return eventStreamId{}, fmt.Errorf("%w {%s}", ErrMalformedEventId, s)
+	}
+	return eventStreamId{aggregateId: s[:i], version: version}, nil
+}
+
+func (id eventStreamId) String() string {
+	return fmt.Sprintf("%s/%d", id.aggregateId, id.version)
+}
+
+// subscription describes the events a client of an event stream asked for.
+type subscription struct {
+	datacenterId string
+	// the aggregate types to send the events of, every type if empty.
+	aggregateTypes map[events.AggregateType]bool
+	// the event to resume the stream after, nil to stream from the first event of the datacenter.
+	resumeAfter *eventStreamId
+}
+
+func newSubscription(r *http.Request, datacenterId string) (subscription, error) {
+	sub := subscription{datacenterId: datacenterId, aggregateTypes: make(map[events.AggregateType]bool)}
+
+	query := r.URL.Query()
+	for _, value := range query[aggregateTypeQuery] {
+		for _, aggregateType := range strings.Split(value, ",") {
+			if aggregateType = strings.TrimSpace(aggregateType); aggregateType != "" {
+				sub.aggregateTypes[events.AggregateType(aggregateType)] = true
+			}
+		}
+	}
+
+	lastEventId := r.Header.Get(lastEventIdHeader)
+	if lastEventId == "" {
+		lastEventId = query.Get(lastEventIdQuery)
+	}
+	if lastEventId != "" {
+		id, err := parseEventStreamId(lastEventId)
+		if err != nil {
+			return subscription{}, err
+		}
+		sub.resumeAfter = &id
+	}
+	return sub, nil
+}
+
+func (sub subscription) matchesType(event events.Event) bool {
+	return len(sub.aggregateTypes) == 0 || sub.aggregateTypes[event.GetAggregateType()]
+}
+
+// streamDatacenterEvents streams the events of the datacenter, and of the racks, pods and devices in it, as they are
+// saved. events are streamed as server-sent events, or over a WebSocket if the request asks to upgrade the connection.
+func (s *server) streamDatacenterEvents(datacenterId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sub, err := newSubscription(r, datacenterId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			// the API doesn't authenticate its clients, so checking the origin of the handshake wouldn't protect
+			// anything the other routes don't already serve.
+			ws := websocket.Server{Handler: func(conn *websocket.Conn) {
+				s.streamWebSocket(conn, sub)
+			}}
+			ws.ServeHTTP(w, r)
+			return
+		}
+		s.streamServerSentEvents(w, r, sub)
+	}
+}
+
+// subscribe tails the events of the subscription into the returned channel, which is closed once the tail ends, the
+// error it ended with is then sent on the error channel. every subscription reads the store on its own, so a client
+// never slows down the writers. the channel is bounded: if the client doesn't take an event within the slow consumer
+// timeout of the buffer filling up, the tail ends with ErrSlowConsumer and the client has to resume the stream.
+func (s *server) subscribe(ctx context.Context, sub subscription) (<-chan streamedEvent, <-chan error) {
+	out := make(chan streamedEvent, s.opts.streamBufferSize)
+	errs := make(chan error, 1)
+
+	send := func(event streamedEvent) error {
+		select {
+		case out <- event:
+			return nil
+		default:
+		}
+
+		timer := time.NewTimer(s.opts.slowConsumerTimeout)
+		defer timer.Stop()
+		select {
+		case out <- event:
+			return nil
+		case <-timer.C:
+			return fmt.Errorf("%w: no event was taken for %s", ErrSlowConsumer, s.opts.slowConsumerTimeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	go func() {
+		defer close(out)
+
+		after := events.LogPosition{}
+		if sub.resumeAfter != nil {
+			position, err := s.resumePosition(ctx, sub)
+			if err != nil {
+				errs <- err
+				return
+			}
+			after = position
+		}
+
+		errs <- api.Tail(ctx, s.reader, after, s.opts.pollInterval, func(event events.Event) error {
+			if !sub.matchesType(event) {
+				return nil
+			}
+			match, err := s.membership.Match(ctx, sub.datacenterId, event)
+			if err != nil || !match {
+				return err
+			}
+			return send(newStreamedEvent(event))
+		}, nil)
+	}()
+
+	return out, errs
+}
+
+// resumePosition returns the position of the event the subscription resumes after, it must be an event of the
+// datacenter.
+func (s *server) resumePosition(ctx context.Context, sub subscription) (events.LogPosition, error) {
+	evts, err := s.reader.LoadEventsFrom(ctx, sub.resumeAfter.aggregateId, sub.resumeAfter.version)
+	if errors.Is(err, events.ErrAggregateNotFound) || (err == nil && (len(evts) == 0 || evts[0].GetVersion() != sub.resumeAfter.version)) {
+		return events.LogPosition{}, fmt.Errorf("%w {%s}", ErrResumeEventNotFound, sub.resumeAfter)
+	}
+	if err != nil {
+		return events.LogPosition{}, err
+	}
+
+	event, err := events.Upcast(evts[0])
+	if err != nil {
+		return events.LogPosition{}, fmt.Errorf("Upcast {%s}: %w", evts[0].GetEventId(), err)
+	}
+	match, err := s.membership.Match(ctx, sub.datacenterId, event)
+	if err != nil {
+		return events.LogPosition{}, err
+	}
+	if !match {
+		return events.LogPosition{}, fmt.Errorf("%w {%s}", ErrResumeEventNotFound, sub.resumeAfter)
+	}
+	return event.GetLogPosition(), nil
+}
+
+// streamContext returns a context that is done when the parent is done or the server closes its streams.
+func (s *server) streamContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-s.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// CloseStreams ends every event stream, http.Server.Shutdown doesn't wait for them since they only end when their
+// client goes away. register it with http.Server.RegisterOnShutdown.
+func (s *server) CloseStreams() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+// streamServerSentEvents writes every event as a server-sent event with the event's type as its type and its
+// eventStreamId as its id, so that EventSource clients resume after the last event they received when they reconnect.
+// a problem that ends the stream is written as an 'error' event.
+func (s *server) streamServerSentEvents(w http.ResponseWriter, r *http.Request, sub subscription) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.writeError(w, r, ErrStreamingUnsupported)
+		return
+	}
+
+	ctx, cancel := s.streamContext(r.Context())
+	defer cancel()
+	stream, errs := s.subscribe(ctx, sub)
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// disables the buffering of responses by nginx.
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(s.opts.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": heartbeat\n\n")
+		case event, ok := <-stream:
+			if !ok {
+				if err = <-errs; err != nil && ctx.Err() == nil {
+					s.writeServerSentError(w, r, err)
+					flusher.Flush()
+				}
+				return
+			}
+			err = writeServerSentEvent(w, event.Id, event.EventType, event)
+		}
+		if err != nil {
+			s.log.Debugf("(httpapi) event stream of datacenter {%s} closed: %v", sub.datacenterId, err)
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (s *server) writeServerSentError(w io.Writer, r *http.Request, err error) {
+	problem := classify(err)
+	if statusCode(problem.Kind) >= http.StatusInternalServerError {
+		s.log.Errorf("(httpapi) %s %s: %v", r.Method, r.URL.Path, err)
+	}
+	if err = writeServerSentEvent(w, "", "error", errorResponse{Error: problem}); err != nil {
+		s.log.Warnf("(httpapi) failed to write event stream error: %v", err)
+	}
+}
+
+func writeServerSentEvent(w io.Writer, id, eventType string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err = fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", eventType, data)
+	return err
+}
+
+// streamWebSocket sends every event as a webSocketMessage. messages sent by the client are ignored, the stream ends
+// when the client closes the connection. a client that doesn't read a message within the slow consumer timeout is
+// disconnected.
+func (s *server) streamWebSocket(conn *websocket.Conn, sub subscription) {
+	defer conn.Close()
+
+	ctx, cancel := s.streamContext(conn.Request().Context())
+	defer cancel()
+	go func() {
+		// reading fails once the client closes the connection.
+		_, _ = io.Copy(io.Discard, conn)
+		cancel()
+	}()
+
+	send := func(message webSocketMessage) error {
+		if err := conn.SetWriteDeadline(time.Now().Add(s.opts.slowConsumerTimeout)); err != nil {
+			return err
+		}
+		return websocket.JSON.Send(conn, message)
+	}
+
+	stream, errs := s.subscribe(ctx, sub)
+	for event := range stream {
+		event := event
+		if err := send(webSocketMessage{Event: &event}); err != nil {
+			s.log.Debugf("(httpapi) event stream of datacenter {%s} closed: %v", sub.datacenterId, err)
+			return
+		}
+	}
+
+	if err := <-errs; err != nil && ctx.Err() == nil {
+		problem := classify(err)
+		if statusCode(problem.Kind) >= http.StatusInternalServerError {
+			s.log.Errorf("(httpapi) websocket %s: %v", conn.Request().URL.Path, err)
+		}
+		if err = send(webSocketMessage{Error: &problem}); err != nil {
+			s.log.Warnf("(httpapi) failed to write event stream error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package httpapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const eventsPath = "/v1/datacenters/dal1/events"
+
+// serverSentEvent is an event read from a server-sent event stream.
+type serverSentEvent struct {
+	id        string
+	eventType string
+	data      string
+}
+
+// serverSentEventStream reads the events of a server-sent event stream.
+type serverSentEventStream struct {
+	resp    *http.Response
+	scanner *bufio.Scanner
+}
+
+// stream opens the server-sent event stream of the path, resuming after lastEventId if it isn't empty.
+func (s *testServer) stream(t *testing.T, path string, lastEventId string) *serverSentEventStream {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url+path, nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	if lastEventId != "" {
+		req.Header.Set(lastEventIdHeader, lastEventId)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s: %v", path, err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	return &serverSentEventStream{resp: resp, scanner: scanner}
+}
+
+// next reads the next event of the stream, false is returned once the stream ends.
+func (s *serverSentEventStream) next() (serverSentEvent, bool) {
+	var event serverSentEvent
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		switch {
+		case line == "" && event.eventType != "":
+			return event, true
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.eventType = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	return serverSentEvent{}, false
+}
+
+// mustNext reads the next n events of the stream and fails the test if the stream ends first.
+func (s *serverSentEventStream) mustNext(t *testing.T, n int) []serverSentEvent {
+	t.Helper()
+
+	evts := make([]serverSentEvent, 0, n)
+	for len(evts) < n {
+		event, ok := s.next()
+		if !ok {
+			t.Fatalf("expected %d events, the stream ended after %v: %v", n, evts, s.scanner.Err())
+		}
+		evts = append(evts, event)
+	}
+	return evts
+}
+
+// ids returns the ids of the events.
+func ids(evts []serverSentEvent) []string {
+	ids := make([]string, 0, len(evts))
+	for _, event := range evts {
+		ids = append(ids, event.id)
+	}
+	return ids
+}
+
+// problemOf returns the problem of an 'error' event.
+func problemOf(t *testing.T, event serverSentEvent) string {
+	t.Helper()
+
+	if event.eventType != "error" {
+		t.Fatalf("expected an error event, got %+v", event)
+	}
+	var problem errorResponse
+	if err := json.Unmarshal([]byte(event.data), &problem); err != nil {
+		t.Fatalf("decoding the problem: %v", err)
+	}
+	return problem.Error.Code
+}
+
+// newInterleavedDatacenters saves the events of dal1 and sjc1 interleaved.
+func newInterleavedDatacenters(t *testing.T, s *testServer) {
+	t.Helper()
+
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "dal1", "site": "dal1"}`)
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "sjc1", "site": "sjc1"}`)
+	s.mustPost(t, "CreateRackCommand", `{"aggregateId": "b01", "name": "b01", "datacenterId": "sjc1"}`)
+	s.mustPost(t, "CreateRackCommand", `{"aggregateId": "a01", "name": "a01", "datacenterId": "dal1"}`)
+	s.mustPost(t, "ReserveRUsCommand", `{"aggregateId": "b01", "rus": "42", "reason": "patching"}`)
+	s.mustPost(t, "ReserveRUsCommand", `{"aggregateId": "a01", "rus": "42", "reason": "patching"}`)
+}
+
+func TestServerSentEvents(t *testing.T) {
+	s := newTestServer(t, WithPollInterval(10*time.Millisecond))
+	newInterleavedDatacenters(t, s)
+
+	all := s.stream(t, eventsPath, "").mustNext(t, 3)
+	expected := []string{"datacenter-dal1/0", "rack-a01/0", "rack-a01/1"}
+	if fmt.Sprint(ids(all)) != fmt.Sprint(expected) {
+		t.Fatalf("expected the events %v, got %v", expected, ids(all))
+	}
+	if all[1].eventType != "V1_RACK_CREATED" {
+		t.Fatalf("expected the type of the event as its event type, got %+v", all[1])
+	}
+
+	t.Run("resume", func(t *testing.T) {
+		resumed := s.stream(t, eventsPath, all[0].id).mustNext(t, 2)
+		if fmt.Sprint(ids(resumed)) != fmt.Sprint(expected[1:]) {
+			t.Fatalf("expected the events %v, got %v", expected[1:], ids(resumed))
+		}
+
+		// events saved after the stream started are streamed as well.
+		stream := s.stream(t, eventsPath, all[2].id)
+		s.mustPost(t, "ReleaseRUsCommand", `{"aggregateId": "a01", "rus": "42"}`)
+		if event := stream.mustNext(t, 1)[0]; event.id != "rack-a01/2" {
+			t.Fatalf("expected the event rack-a01/2, got %+v", event)
+		}
+	})
+
+	t.Run("resume from another datacenter", func(t *testing.T) {
+		for _, lastEventId := range []string{"rack-b01/0", "rack-c01/0", "rack-a01/9"} {
+			event, ok := s.stream(t, eventsPath, lastEventId).next()
+			if !ok || problemOf(t, event) != "RESUME_EVENT_NOT_FOUND" {
+				t.Fatalf("resuming after %s: expected RESUME_EVENT_NOT_FOUND, got %+v", lastEventId, event)
+			}
+		}
+
+		stream := s.stream(t, eventsPath, "rack-a01")
+		var problem errorResponse
+		if err := json.NewDecoder(stream.resp.Body).Decode(&problem); err != nil {
+			t.Fatalf("decoding the problem: %v", err)
+		}
+		if stream.resp.StatusCode != http.StatusBadRequest || problem.Error.Code != "MALFORMED_EVENT_ID" {
+			t.Fatalf("expected 400 MALFORMED_EVENT_ID, got %d %s", stream.resp.StatusCode, problem.Error.Code)
+		}
+	})
+
+	t.Run("aggregate type", func(t *testing.T) {
+		racks := s.stream(t, eventsPath+"?aggregateType=rack", "").mustNext(t, 2)
+		if fmt.Sprint(ids(racks)) != fmt.Sprint(expected[1:]) {
+			t.Fatalf("expected the events %v, got %v", expected[1:], ids(racks))
+		}
+		datacenters := s.stream(t, eventsPath+"?aggregateType=pod,datacenter", "").mustNext(t, 1)
+		if datacenters[0].id != expected[0] {
+			t.Fatalf("expected the event %s, got %v", expected[0], ids(datacenters))
+		}
+	})
+}
+
+func TestServerSentEventsSlowConsumer(t *testing.T) {
+	s := newTestServer(t, WithPollInterval(10*time.Millisecond), WithStreamBufferSize(1), WithSlowConsumerTimeout(50*time.Millisecond))
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "dal1", "site": "dal1"}`)
+
+	stream := s.stream(t, eventsPath, "")
+	stream.mustNext(t, 1)
+
+	// the client stops reading, the events are large enough to fill the buffers of the connection long before the
+	// last of them is written.
+	const racks = 64
+	name := strings.Repeat("a", 256*1024)
+	for i := 0; i < racks; i++ {
+		started := time.Now()
+		s.mustPost(t, "CreateRackCommand", fmt.Sprintf(`{"aggregateId": "a%02d", "name": "%s", "datacenterId": "dal1"}`, i, name))
+		if elapsed := time.Since(started); elapsed > time.Second {
+			t.Fatalf("expected the stalled client not to block the commands, CreateRack took %s", elapsed)
+		}
+	}
+	time.Sleep(time.Second)
+
+	// the client catches up with what was written before it was disconnected.
+	var streamed []serverSentEvent
+	for {
+		event, ok := stream.next()
+		if !ok {
+			break
+		}
+		streamed = append(streamed, event)
+	}
+	if len(streamed) == 0 || len(streamed) > racks {
+		t.Fatalf("expected the stream to end before the last rack, got %d events", len(streamed))
+	}
+	if code := problemOf(t, streamed[len(streamed)-1]); code != "SLOW_CONSUMER" {
+		t.Fatalf("expected the stream to end with SLOW_CONSUMER, got %s", code)
+	}
+}
+
+// dialWebSocket opens the WebSocket event stream of the path.
+func (s *testServer) dialWebSocket(t *testing.T, path string) *websocket.Conn {
+	t.Helper()
+
+	url := "ws" + strings.TrimPrefix(s.url, "http") + path
+	conn, err := websocket.Dial(url, "", s.url)
+	if err != nil {
+		t.Fatalf("Dial %s: %v", path, err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// receive receives the next message of the WebSocket event stream.
+func receive(t *testing.T, conn *websocket.Conn) webSocketMessage {
+	t.Helper()
+
+	if err := conn.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatalf("SetReadDeadline: %v", err)
+	}
+	var message webSocketMessage
+	if err := websocket.JSON.Receive(conn, &message); err != nil {
+		t.Fatalf("Receive: %v", err)
+	}
+	return message
+}
+
+func TestServerWebSocketEvents(t *testing.T) {
+	s := newTestServer(t, WithPollInterval(10*time.Millisecond))
+	newInterleavedDatacenters(t, s)
+
+	// resumed after the creation of the rack, without the events of the datacenter.
+	conn := s.dialWebSocket(t, eventsPath+"?aggregateType=rack&lastEventId=rack-a01/0")
+	if message := receive(t, conn); message.Event == nil || message.Event.Id != "rack-a01/1" {
+		t.Fatalf("expected the event rack-a01/1, got %+v", message)
+	}
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "dal2", "site": "dal2"}`)
+	s.mustPost(t, "ReleaseRUsCommand", `{"aggregateId": "a01", "rus": "42"}`)
+	if message := receive(t, conn); message.Event == nil || message.Event.Id != "rack-a01/2" {
+		t.Fatalf("expected the event rack-a01/2, got %+v", message)
+	}
+
+	conn = s.dialWebSocket(t, eventsPath+"?lastEventId=rack-b01/0")
+	if message := receive(t, conn); message.Error == nil || message.Error.Code != "RESUME_EVENT_NOT_FOUND" {
+		t.Fatalf("expected RESUME_EVENT_NOT_FOUND, got %+v", message)
+	}
+}
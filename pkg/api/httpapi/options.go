@@ -0,0 +1,106 @@
+package httpapi
+
+import (
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+)
+
+const (
+	defaultMaxBodyBytes        = 1 << 20
+	defaultPollInterval        = time.Second
+	defaultStreamBufferSize    = 64
+	defaultSlowConsumerTimeout = 10 * time.Second
+	defaultHeartbeatInterval   = 15 * time.Second
+)
+
+type serverOptions struct {
+	// the largest command body accepted.
+	maxBodyBytes int64
+	// how often event streams poll the store for new events.
+	pollInterval time.Duration
+	// the number of events buffered between the store and a client of an event stream.
+	streamBufferSize int
+	// how long an event stream waits for a client to make room in a full buffer before it ends the stream.
+	slowConsumerTimeout time.Duration
+	// how often an idle server-sent event stream sends a comment to keep the connection open.
+	heartbeatInterval time.Duration
+	// the metrics of the commands handled by the bus, not served if nil.
+	metrics CommandMetricsSource
+}
+
+// CommandMetricsSource returns the metrics of every command type, keyed by command name. the recorder of the
+// commands.Metrics middleware returned by commands.NewMemoryMetrics implements it.
+type CommandMetricsSource interface {
+	Snapshot() map[string]commands.CommandMetrics
+}
+
+type ServerOption func(*serverOptions)
+
+func newServerOptions(opts ...ServerOption) serverOptions {
+	o := serverOptions{
+		maxBodyBytes:        defaultMaxBodyBytes,
+		pollInterval:        defaultPollInterval,
+		streamBufferSize:    defaultStreamBufferSize,
+		slowConsumerTimeout: defaultSlowConsumerTimeout,
+		heartbeatInterval:   defaultHeartbeatInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithMaxBodyBytes sets the largest command body the server accepts. (default 1MiB)
+func WithMaxBodyBytes(n int64) ServerOption {
+	return func(o *serverOptions) {
+		if n > 0 {
+			o.maxBodyBytes = n
+		}
+	}
+}
+
+// WithPollInterval sets how often event streams poll the store for new events. (default 1s)
+func WithPollInterval(interval time.Duration) ServerOption {
+	return func(o *serverOptions) {
+		if interval > 0 {
+			o.pollInterval = interval
+		}
+	}
+}
+
+// WithStreamBufferSize sets the number of events buffered for each client of an event stream. (default 64)
+func WithStreamBufferSize(n int) ServerOption {
+	return func(o *serverOptions) {
+		if n > 0 {
+			o.streamBufferSize = n
+		}
+	}
+}
+
+// WithSlowConsumerTimeout sets how long an event stream waits for a client that has fallen a full buffer behind
+// before it ends the stream. (default 10s)
+func WithSlowConsumerTimeout(timeout time.Duration) ServerOption {
+	return func(o *serverOptions) {
+		if timeout > 0 {
+			o.slowConsumerTimeout = timeout
+		}
+	}
+}
+
+// WithHeartbeatInterval sets how often an idle server-sent event stream sends a comment so that proxies don't close
+// the connection. (default 15s)
+func WithHeartbeatInterval(interval time.Duration) ServerOption {
+	return func(o *serverOptions) {
+		if interval > 0 {
+			o.heartbeatInterval = interval
+		}
+	}
+}
+
+// WithCommandMetrics serves the metrics of the commands handled by the bus on GET /v1/metrics. (default: not served)
+func WithCommandMetrics(metrics CommandMetricsSource) ServerOption {
+	return func(o *serverOptions) {
+		o.metrics = metrics
+	}
+}
@@ -0,0 +1,168 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+)
+
+func (s *server) listDatacenters(w http.ResponseWriter, r *http.Request) {
+	dcs, err := s.repos.Datacenters.List(r.Context())
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, newListResponse(dcs))
+}
+
+func (s *server) getDatacenter(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		dc, err := s.repos.Datacenters.GetById(r.Context(), id)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, dc)
+	}
+}
+
+func (s *server) listDatacenterRacks(datacenterId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		racks, err := s.repos.Racks.ListByDatacenter(r.Context(), datacenterId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, newListResponse(racks))
+	}
+}
+
+func (s *server) listDatacenterPods(datacenterId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pods, err := s.repos.Pods.ListByDatacenter(r.Context(), datacenterId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, newListResponse(pods))
+	}
+}
+
+func (s *server) getRack(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rack, err := s.repos.Racks.GetById(r.Context(), id)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, rack)
+	}
+}
+
+func (s *server) listRackDevices(rackId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		devices, err := s.repos.Devices.ListByRack(r.Context(), rackId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, newListResponse(devices))
+	}
+}
+
+// getRackPower reports the load of the devices of the rack on its feeds, and their load when any one of them fails.
+func (s *server) getRackPower(rackId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rack, err := s.repos.Racks.GetById(r.Context(), rackId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, rack.PowerReport())
+	}
+}
+
+func (s *server) getPod(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pod, err := s.repos.Pods.GetById(r.Context(), id)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, pod)
+	}
+}
+
+func (s *server) listPodDevices(podId string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		devices, err := s.repos.Devices.ListByPod(r.Context(), podId)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, newListResponse(devices))
+	}
+}
+
+// findDevices finds the device with the hostname, or the devices in the category, passed in the query.
+func (s *server) findDevices(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	switch {
+	case query.Get("hostname") != "":
+		device, err := s.repos.Devices.GetByHostname(r.Context(), query.Get("hostname"))
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, device)
+	case query.Get("category") != "":
+		devices, err := s.repos.Devices.ListByCategory(r.Context(), query.Get("category"))
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, newListResponse(devices))
+	default:
+		s.writeError(w, r, fmt.Errorf("%w: hostname or category", ErrMissingQuery))
+	}
+}
+
+func (s *server) getDevice(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		device, err := s.repos.Devices.GetById(r.Context(), id)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, device)
+	}
+}
+
+func (s *server) listDeviceTemplates(w http.ResponseWriter, r *http.Request) {
+	var (
+		templates []*projections.DeviceTemplateProjection
+		err       error
+	)
+	if category := r.URL.Query().Get("category"); category != "" {
+		templates, err = s.repos.DeviceTemplates.ListByCategory(r.Context(), category)
+	} else {
+		templates, err = s.repos.DeviceTemplates.List(r.Context())
+	}
+	if err != nil {
+		s.writeError(w, r, err)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, newListResponse(templates))
+}
+
+func (s *server) getDeviceTemplate(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		template, err := s.repos.DeviceTemplates.GetById(r.Context(), id)
+		if err != nil {
+			s.writeError(w, r, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, template)
+	}
+}
@@ -0,0 +1,88 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+)
+
+// errorResponse is the body of every response to a request that failed.
+type errorResponse struct {
+	Error api.Problem `json:"error"`
+}
+
+// listResponse is the body of every response to a request for a list of resources.
+type listResponse[T any] struct {
+	Items []T `json:"items"`
+}
+
+func newListResponse[T any](items []T) listResponse[T] {
+	if items == nil {
+		items = make([]T, 0)
+	}
+	return listResponse[T]{Items: items}
+}
+
+func (s *server) writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.log.Warnf("(httpapi) failed to write response: %v", err)
+	}
+}
+
+// writeError writes the problem that describes err, with the status code of its kind.
+func (s *server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	problem := classify(err)
+	status := statusCode(problem.Kind)
+	if status >= http.StatusInternalServerError {
+		s.log.Errorf("(httpapi) %s %s: %v", r.Method, r.URL.Path, err)
+	}
+	s.writeJSON(w, status, errorResponse{Error: problem})
+}
+
+// classify is like api.Classify but also classifies the errors of the transport.
+func classify(err error) api.Problem {
+	switch {
+	case errors.Is(err, ErrRouteNotFound):
+		return api.Problem{Kind: api.NotFound, Code: "ROUTE_NOT_FOUND", Message: err.Error()}
+	case errors.Is(err, ErrMethodNotAllowed):
+		return api.Problem{Kind: methodNotAllowed, Code: "METHOD_NOT_ALLOWED", Message: err.Error()}
+	case errors.Is(err, ErrMalformedBody):
+		return api.Problem{Kind: api.InvalidArgument, Code: "MALFORMED_BODY", Message: err.Error()}
+	case errors.Is(err, ErrMissingQuery):
+		return api.Problem{Kind: api.InvalidArgument, Code: "MISSING_QUERY_PARAMETER", Message: err.Error()}
+	case errors.Is(err, ErrMalformedEventId):
+		return api.Problem{Kind: api.InvalidArgument, Code: "MALFORMED_EVENT_ID", Message: err.Error()}
+	case errors.Is(err, ErrResumeEventNotFound):
+		return api.Problem{Kind: api.NotFound, Code: "RESUME_EVENT_NOT_FOUND", Message: err.Error()}
+	case errors.Is(err, ErrSlowConsumer):
+		return api.Problem{Kind: api.Aborted, Code: "SLOW_CONSUMER", Message: err.Error()}
+	case errors.Is(err, ErrStreamingUnsupported):
+		return api.Problem{Kind: api.Internal, Code: "STREAMING_UNSUPPORTED", Message: err.Error()}
+	}
+	return api.Classify(err)
+}
+
+// methodNotAllowed is only produced by the transport, it has no equivalent in the other transports.
+const methodNotAllowed api.Kind = "method_not_allowed"
+
+func statusCode(kind api.Kind) int {
+	switch kind {
+	case api.InvalidArgument:
+		return http.StatusBadRequest
+	case api.NotFound:
+		return http.StatusNotFound
+	case methodNotAllowed:
+		return http.StatusMethodNotAllowed
+	case api.AlreadyExists, api.Aborted:
+		return http.StatusConflict
+	case api.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	case api.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
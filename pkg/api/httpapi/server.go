@@ -0,0 +1,154 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// CommandBus handles the commands posted to the server, commands.Bus implements it.
+type CommandBus interface {
+	events.HandleCommand
+	// NewCommand returns a zero command of the type named name, false is returned if the bus can't handle it.
+	NewCommand(name string) (events.Command, bool)
+	// CommandNames returns the names of the commands the bus can handle.
+	CommandNames() []string
+}
+
+// EventReader reads the events the event streams are served from, the event stores implement it.
+type EventReader interface {
+	events.AllEventsReader
+	// LoadEventsFrom loads the events of the stream with a version greater than or equal to from, see events.EventStore.
+	LoadEventsFrom(ctx context.Context, streamId string, from int64) ([]events.Event, error)
+}
+
+// server is an http.Handler that serves the REST API:
+//
+//	GET  /v1/commands                      the names of the commands that can be posted
+//	POST /v1/commands/{name}               handle the command in the body, e.g. /v1/commands/CreateRackCommand
+//	GET  /v1/datacenters                   list datacenters
+//	GET  /v1/datacenters/{id}              get a datacenter
+//	GET  /v1/datacenters/{id}/racks        list the racks of a datacenter
+//	GET  /v1/datacenters/{id}/pods         list the pods of a datacenter
+//	GET  /v1/datacenters/{id}/events       stream the events of a datacenter, as server-sent events or over a WebSocket
+//	GET  /v1/racks/{id}                    get a rack
+//	GET  /v1/racks/{id}/devices            list the devices of a rack, ordered by elevation
+//	GET  /v1/pods/{id}                     get a pod
+//	GET  /v1/pods/{id}/devices             list the devices of a pod
+//	GET  /v1/devices?hostname=|category=   find devices by hostname or category
+//	GET  /v1/devices/{id}                  get a device
+//	GET  /v1/device-templates[?category=]  list device templates
+//	GET  /v1/device-templates/{id}         get a device template
+//	GET  /v1/metrics                       the metrics of the commands handled, see WithCommandMetrics
+//
+// queries are served from the projections, which are eventually consistent with the commands. event streams are
+// read from the store.
+type server struct {
+	log    logger.Logger
+	bus    CommandBus
+	repos  api.Repositories
+	reader EventReader
+	opts   serverOptions
+
+	// the datacenter the aggregates are in, shared by the event streams.
+	membership *api.DatacenterMembership
+
+	// closed once the event streams are asked to end, see CloseStreams.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewServer(log logger.Logger, bus CommandBus, repos api.Repositories, reader EventReader, opts ...ServerOption) *server {
+	return &server{
+		log:        log,
+		bus:        bus,
+		repos:      repos,
+		reader:     reader,
+		opts:       newServerOptions(opts...),
+		membership: api.NewDatacenterMembership(reader),
+		closed:     make(chan struct{}),
+	}
+}
+
+// route is the handler of a path, indexed by method.
+type route map[string]http.HandlerFunc
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "v1" {
+		s.writeError(w, r, fmt.Errorf("%w {%s}", ErrRouteNotFound, r.URL.Path))
+		return
+	}
+
+	routes, ok := s.match(segments[1:])
+	if !ok {
+		s.writeError(w, r, fmt.Errorf("%w {%s}", ErrRouteNotFound, r.URL.Path))
+		return
+	}
+
+	handler, ok := routes[r.Method]
+	if !ok {
+		allowed := make([]string, 0, len(routes))
+		for method := range routes {
+			allowed = append(allowed, method)
+		}
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		s.writeError(w, r, fmt.Errorf("%w {%s %s}", ErrMethodNotAllowed, r.Method, r.URL.Path))
+		return
+	}
+	handler(w, r)
+}
+
+// match returns the routes of the path, without its version prefix.
+func (s *server) match(path []string) (route, bool) {
+	resource, rest := path[0], path[1:]
+	switch {
+	case resource == "commands" && len(rest) == 0:
+		return route{http.MethodGet: s.listCommands}, true
+	case resource == "commands" && len(rest) == 1:
+		return route{http.MethodPost: s.handleCommand(rest[0])}, true
+
+	case resource == "datacenters" && len(rest) == 0:
+		return route{http.MethodGet: s.listDatacenters}, true
+	case resource == "datacenters" && len(rest) == 1:
+		return route{http.MethodGet: s.getDatacenter(rest[0])}, true
+	case resource == "datacenters" && len(rest) == 2 && rest[1] == "racks":
+		return route{http.MethodGet: s.listDatacenterRacks(rest[0])}, true
+	case resource == "datacenters" && len(rest) == 2 && rest[1] == "pods":
+		return route{http.MethodGet: s.listDatacenterPods(rest[0])}, true
+	case resource == "datacenters" && len(rest) == 2 && rest[1] == "events":
+		return route{http.MethodGet: s.streamDatacenterEvents(rest[0])}, true
+
+	case resource == "racks" && len(rest) == 1:
+		return route{http.MethodGet: s.getRack(rest[0])}, true
+	case resource == "racks" && len(rest) == 2 && rest[1] == "devices":
+		return route{http.MethodGet: s.listRackDevices(rest[0])}, true
+	case resource == "racks" && len(rest) == 2 && rest[1] == "power":
+		return route{http.MethodGet: s.getRackPower(rest[0])}, true
+
+	case resource == "pods" && len(rest) == 1:
+		return route{http.MethodGet: s.getPod(rest[0])}, true
+	case resource == "pods" && len(rest) == 2 && rest[1] == "devices":
+		return route{http.MethodGet: s.listPodDevices(rest[0])}, true
+
+	case resource == "devices" && len(rest) == 0:
+		return route{http.MethodGet: s.findDevices}, true
+	case resource == "devices" && len(rest) == 1:
+		return route{http.MethodGet: s.getDevice(rest[0])}, true
+
+	case resource == "device-templates" && len(rest) == 0:
+		return route{http.MethodGet: s.listDeviceTemplates}, true
+	case resource == "device-templates" && len(rest) == 1:
+		return route{http.MethodGet: s.getDeviceTemplate(rest[0])}, true
+
+	case resource == "metrics" && len(rest) == 0 && s.opts.metrics != nil:
+		return route{http.MethodGet: s.getMetrics}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,289 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+)
+
+// testStore is the store of a test server.
+type testStore interface {
+	events.AggregateStore
+	EventReader
+}
+
+// conflictingStore is a testStore whose saves can be made to lose every race with a concurrent command.
+type conflictingStore struct {
+	testStore
+
+	mu       sync.Mutex
+	conflict bool
+}
+
+func (s *conflictingStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	s.mu.Lock()
+	conflict := s.conflict
+	s.mu.Unlock()
+
+	if conflict {
+		return fmt.Errorf("%w {%s}", events.ErrConcurrencyConflict, aggregate.GetId())
+	}
+	return s.testStore.Save(ctx, aggregate)
+}
+
+func (s *conflictingStore) setConflict(conflict bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conflict = conflict
+}
+
+// testServer is the REST API served over a local listener.
+type testServer struct {
+	url   string
+	store *conflictingStore
+	// projects the events saved so far into the repositories the queries are served from.
+	runner *projectors.Runner
+}
+
+func newTestServer(t *testing.T, opts ...ServerOption) *testServer {
+	t.Helper()
+
+	log := logger.NewLogger("test")
+	store := &conflictingStore{testStore: eventstore.NewMemoryStore()}
+	bus := commands.NewBus(commands.Validation(), commands.Idempotency(log, commands.NewMemoryDedupStore(), time.Minute))
+	if err := v1.RegisterHandlers(bus, store, log, v1.WithMaxRetries(1), v1.WithRetryBackoff(time.Millisecond, time.Millisecond)); err != nil {
+		t.Fatalf("RegisterHandlers: %v", err)
+	}
+	repos := api.Repositories{
+		Datacenters:     projections.NewMemoryDatacenterRepository(),
+		Racks:           projections.NewMemoryRackRepository(),
+		Pods:            projections.NewMemoryPodRepository(),
+		Devices:         projections.NewMemoryDeviceRepository(),
+		DeviceTemplates: projections.NewMemoryDeviceTemplateRepository(),
+	}
+	runner := projectors.NewRunner(log, store, projectors.NewMemoryCheckpointStore(), []projectors.Projector{
+		projectors.NewDatacenterProjector(repos.Datacenters),
+		projectors.NewRackProjector(repos.Racks),
+		projectors.NewPodProjector(repos.Pods),
+		projectors.NewDeviceProjector(repos.Devices, repos.Racks),
+		projectors.NewDeviceTemplateProjector(repos.DeviceTemplates),
+	})
+
+	srv := NewServer(log, bus, repos, store, opts...)
+	ts := httptest.NewServer(srv)
+	t.Cleanup(func() {
+		srv.CloseStreams()
+		ts.Close()
+	})
+
+	return &testServer{url: ts.URL, store: store, runner: runner}
+}
+
+// post posts the command named name and returns the status code of the response and the problem it describes, if any.
+func (s *testServer) post(t *testing.T, name string, body string) (int, api.Problem) {
+	t.Helper()
+
+	resp, err := http.Post(s.url+"/v1/commands/"+name, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST %s: %v", name, err)
+	}
+	defer resp.Body.Close()
+
+	var problem errorResponse
+	if resp.StatusCode >= http.StatusBadRequest {
+		if err = json.NewDecoder(resp.Body).Decode(&problem); err != nil {
+			t.Fatalf("decoding the problem of %s: %v", name, err)
+		}
+	}
+	return resp.StatusCode, problem.Error
+}
+
+// mustPost posts the command named name and fails the test if it isn't accepted.
+func (s *testServer) mustPost(t *testing.T, name string, body string) {
+	t.Helper()
+
+	if status, problem := s.post(t, name, body); status != http.StatusAccepted {
+		t.Fatalf("expected %s to be accepted, got %d: %v", name, status, problem)
+	}
+}
+
+// get gets the path and decodes the body of the response into v, it returns the status code of the response.
+func (s *testServer) get(t *testing.T, path string, v any) int {
+	t.Helper()
+
+	resp, err := http.Get(s.url + path)
+	if err != nil {
+		t.Fatalf("GET %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatalf("decoding the body of %s: %v", path, err)
+	}
+	return resp.StatusCode
+}
+
+func TestServerErrorMapping(t *testing.T) {
+	s := newTestServer(t)
+
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "dal1", "site": "dal1"}`)
+	s.mustPost(t, "CreateRackCommand", `{"aggregateId": "a01", "name": "a01", "size": 1, "datacenterId": "dal1"}`)
+	s.mustPost(t, "CreateDeviceTemplateCommand", `{"aggregateId": "t1", "modelId": "srv", "formFactor": 2, "hostnameTemplate": "{{.Site}}-srv{{.Number}}"}`)
+
+	tests := []struct {
+		name           string
+		command        string
+		body           string
+		conflict       bool
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name:           "invalid command",
+			command:        "CreateRackCommand",
+			body:           `{"aggregateId": "a02", "datacenterId": "dal1"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "RACK_NAME_NOT_SPECIFIED",
+		},
+		{
+			name:           "failed precondition",
+			command:        "CreateDeviceCommand",
+			body:           `{"aggregateId": "d1", "templateId": "t1", "rackId": "a01"}`,
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedCode:   "CANT_FIT_DEVICE_IN_RACK",
+		},
+		{
+			name:           "malformed range",
+			command:        "ReserveRUsCommand",
+			body:           `{"aggregateId": "a01", "rus": "1-a"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "MALFORMED_RANGE",
+		},
+		{
+			name:           "aggregate not found",
+			command:        "DeleteRackCommand",
+			body:           `{"aggregateId": "b01", "reason": "test"}`,
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "NOT_FOUND",
+		},
+		{
+			name:           "concurrency conflict",
+			command:        "DeleteRackCommand",
+			body:           `{"aggregateId": "a01", "reason": "test"}`,
+			conflict:       true,
+			expectedStatus: http.StatusConflict,
+			expectedCode:   "CONCURRENCY_CONFLICT",
+		},
+		{
+			name:           "unknown field",
+			command:        "CreateRackCommand",
+			body:           `{"aggregateId": "a02", "nmae": "a02", "datacenterId": "dal1"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "MALFORMED_BODY",
+		},
+		{
+			name:           "unknown command",
+			command:        "LaunchRackCommand",
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "UNKNOWN_COMMAND",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s.store.setConflict(test.conflict)
+			defer s.store.setConflict(false)
+
+			status, problem := s.post(t, test.command, test.body)
+			if status != test.expectedStatus || problem.Code != test.expectedCode {
+				t.Fatalf("expected %d %s, got %d %s: %s", test.expectedStatus, test.expectedCode, status, problem.Code, problem.Message)
+			}
+		})
+	}
+}
+
+func TestServerQueries(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+
+	s.mustPost(t, "InitDatacenterCommand", `{"aggregateId": "dal1", "site": "dal1"}`)
+	s.mustPost(t, "CreateRackCommand", `{"aggregateId": "a01", "name": "a01", "size": 42, "datacenterId": "dal1"}`)
+	s.mustPost(t, "CreatePodCommand", `{"aggregateId": "p1", "function": "compute", "datacenterId": "dal1"}`)
+	s.mustPost(t, "CreateDeviceTemplateCommand", `{"aggregateId": "t1", "modelId": "srv", "formFactor": 1, "categories": ["compute"], "hostnameTemplate": "{{.Site}}-srv{{.Number}}"}`)
+	s.mustPost(t, "CreateDeviceCommand", `{"aggregateId": "d1", "templateId": "t1", "rackId": "a01", "podId": "p1", "elevation": 10}`)
+	if err := s.runner.CatchUp(ctx); err != nil {
+		t.Fatalf("CatchUp: %v", err)
+	}
+
+	var dc projections.DatacenterProjection
+	if status := s.get(t, "/v1/datacenters/dal1", &dc); status != http.StatusOK || dc.Site != "dal1" {
+		t.Fatalf("expected the datacenter dal1, got %d %+v", status, dc)
+	}
+	var dcs listResponse[projections.DatacenterProjection]
+	if status := s.get(t, "/v1/datacenters", &dcs); status != http.StatusOK || len(dcs.Items) != 1 {
+		t.Fatalf("expected 1 datacenter, got %d %+v", status, dcs)
+	}
+	var racks listResponse[projections.RackProjection]
+	if status := s.get(t, "/v1/datacenters/dal1/racks", &racks); status != http.StatusOK || len(racks.Items) != 1 || racks.Items[0].ID != "a01" {
+		t.Fatalf("expected the rack a01, got %d %+v", status, racks)
+	}
+	var pods listResponse[projections.PodProjection]
+	if status := s.get(t, "/v1/datacenters/dal1/pods", &pods); status != http.StatusOK || len(pods.Items) != 1 || pods.Items[0].ID != "p1" {
+		t.Fatalf("expected the pod p1, got %d %+v", status, pods)
+	}
+	var rack projections.RackProjection
+	if status := s.get(t, "/v1/racks/a01", &rack); status != http.StatusOK || rack.Size != 42 {
+		t.Fatalf("expected a 42U rack, got %d %+v", status, rack)
+	}
+	var devices listResponse[projections.DeviceProjection]
+	if status := s.get(t, "/v1/racks/a01/devices", &devices); status != http.StatusOK || len(devices.Items) != 1 || devices.Items[0].Elevation != 10 {
+		t.Fatalf("expected the device at 10, got %d %+v", status, devices)
+	}
+	if status := s.get(t, "/v1/pods/p1/devices", &devices); status != http.StatusOK || len(devices.Items) != 1 {
+		t.Fatalf("expected 1 device in the pod, got %d %+v", status, devices)
+	}
+	if status := s.get(t, "/v1/devices?category=compute", &devices); status != http.StatusOK || len(devices.Items) != 1 {
+		t.Fatalf("expected 1 compute device, got %d %+v", status, devices)
+	}
+	var device projections.DeviceProjection
+	if status := s.get(t, "/v1/devices/d1", &device); status != http.StatusOK || device.Hostname != "DAL1-SRV01" {
+		t.Fatalf("expected the device DAL1-SRV01, got %d %+v", status, device)
+	}
+	var templates listResponse[projections.DeviceTemplateProjection]
+	if status := s.get(t, "/v1/device-templates?category=compute", &templates); status != http.StatusOK || len(templates.Items) != 1 {
+		t.Fatalf("expected 1 compute template, got %d %+v", status, templates)
+	}
+
+	// the errors of the queries and of the routes.
+	tests := []struct {
+		path           string
+		expectedStatus int
+		expectedCode   string
+	}{
+		{path: "/v1/racks/b01", expectedStatus: http.StatusNotFound, expectedCode: "NOT_FOUND"},
+		{path: "/v1/devices", expectedStatus: http.StatusBadRequest, expectedCode: "MISSING_QUERY_PARAMETER"},
+		{path: "/v1/shelves", expectedStatus: http.StatusNotFound, expectedCode: "ROUTE_NOT_FOUND"},
+		{path: "/v1/commands/CreateRackCommand", expectedStatus: http.StatusMethodNotAllowed, expectedCode: "METHOD_NOT_ALLOWED"},
+	}
+	for _, test := range tests {
+		var problem errorResponse
+		if status := s.get(t, test.path, &problem); status != test.expectedStatus || problem.Error.Code != test.expectedCode {
+			t.Fatalf("GET %s: expected %d %s, got %d %s", test.path, test.expectedStatus, test.expectedCode, status, problem.Error.Code)
+		}
+	}
+}
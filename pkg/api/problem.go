@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
+)
+
+// Kind is the class of a Problem, each transport maps it to its own status codes.
+type Kind string
+
+const (
+	// the request can't be handled as it is, no matter the state of the datacenter.
+	InvalidArgument Kind = "invalid_argument"
+	// the request references a resource that doesn't exist.
+	NotFound Kind = "not_found"
+	// the request creates a resource that already exists.
+	AlreadyExists Kind = "already_exists"
+	// the request can't be handled in the current state of the datacenter.
+	FailedPrecondition Kind = "failed_precondition"
+	// the request lost a race with a concurrent request and may be retried.
+	Aborted          Kind = "aborted"
+	DeadlineExceeded Kind = "deadline_exceeded"
+	Internal         Kind = "internal"
+)
+
+// Problem is the transport independent description of an error returned to a client.
+type Problem struct {
+	Kind Kind `json:"-"`
+	// a stable, machine-readable code for the error (e.g. 'RACK_NAME_NOT_SPECIFIED').
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type classification struct {
+	err  error
+	kind Kind
+	code string
+}
+
+// classifications are checked in order, the first error that matches classifies the problem.
+var classifications = []classification{
+	// datacenters
+	{datacenterAggregate.ErrSiteNotSpecified, InvalidArgument, "SITE_NOT_SPECIFIED"},
+	{datacenterAggregate.ErrInvalidProviderTransferSpeed, InvalidArgument, "INVALID_PROVIDER_TRANSFER_SPEED"},
+	{datacenterAggregate.ErrPodIDNotProvided, InvalidArgument, "POD_ID_NOT_PROVIDED"},
+	{datacenterAggregate.ErrRackIDNotProvided, InvalidArgument, "RACK_ID_NOT_PROVIDED"},
+	{datacenterAggregate.ErrDeviceIDNotProvided, InvalidArgument, "DEVICE_ID_NOT_PROVIDED"},
+	{datacenterAggregate.ErrDeviceAlreadyAdded, AlreadyExists, "DEVICE_ALREADY_ADDED"},
+	{datacenterAggregate.ErrPodAlreadyAdded, AlreadyExists, "POD_ALREADY_ADDED"},
+	{datacenterAggregate.ErrRackAlreadyAdded, AlreadyExists, "RACK_ALREADY_ADDED"},
+
+	// racks
+	{rackAggregate.ErrRackNameNotSpecified, InvalidArgument, "RACK_NAME_NOT_SPECIFIED"},
+	{rackAggregate.ErrDatacenterIDNotProvided, InvalidArgument, "DATACENTER_ID_NOT_PROVIDED"},
+	{rackAggregate.ErrDeviceIDNotProvided, InvalidArgument, "DEVICE_ID_NOT_PROVIDED"},
+	{rackAggregate.ErrDeviceFormFactorNotProvided, InvalidArgument, "DEVICE_FORM_FACTOR_NOT_PROVIDED"},
+	{rackAggregate.ErrDeviceAlreadyRacked, AlreadyExists, "DEVICE_ALREADY_RACKED"},
+	{rackAggregate.ErrDeviceNotRacked, FailedPrecondition, "DEVICE_NOT_RACKED"},
+	{rackAggregate.ErrRackDeleted, FailedPrecondition, "RACK_DELETED"},
+	{rackAggregate.ErrRackNotEmpty, FailedPrecondition, "RACK_NOT_EMPTY"},
+	{datacenter.ErrUnableToFitDevice, FailedPrecondition, "UNABLE_TO_FIT_DEVICE"},
+	{rackAggregate.ErrPowerFeedNotProvided, InvalidArgument, "POWER_FEED_NOT_PROVIDED"},
+	{rackAggregate.ErrInvalidPowerFeed, InvalidArgument, "INVALID_POWER_FEED"},
+	{rackAggregate.ErrInvalidDeratingThreshold, InvalidArgument, "INVALID_DERATING_THRESHOLD"},
+	{rackAggregate.ErrInvalidPowerPolicy, InvalidArgument, "INVALID_POWER_POLICY"},
+	{datacenter.ErrPowerBudgetExceeded, FailedPrecondition, "POWER_BUDGET_EXCEEDED"},
+	{rackAggregate.ErrLoadRatingNotProvided, InvalidArgument, "LOAD_RATING_NOT_PROVIDED"},
+	{rackAggregate.ErrInvalidLoadRating, InvalidArgument, "INVALID_LOAD_RATING"},
+	{datacenter.ErrRackOverloaded, FailedPrecondition, "RACK_OVERLOADED"},
+	{datacenter.ErrHeavyDeviceTooHigh, FailedPrecondition, "HEAVY_DEVICE_TOO_HIGH"},
+	{rackAggregate.ErrReservedRUsNotProvided, InvalidArgument, "RESERVED_RUS_NOT_PROVIDED"},
+	{rackAggregate.ErrInvalidReservation, InvalidArgument, "INVALID_RESERVATION"},
+	{rackAggregate.ErrRUsAlreadyReserved, FailedPrecondition, "RUS_ALREADY_RESERVED"},
+	{rackAggregate.ErrRUsNotReserved, FailedPrecondition, "RUS_NOT_RESERVED"},
+	{datacenter.ErrRUsReserved, FailedPrecondition, "RUS_RESERVED"},
+	{ranges.ErrMalformedRange, InvalidArgument, "MALFORMED_RANGE"},
+
+	// pods
+	{podAggregate.ErrFunctionNotSpecified, InvalidArgument, "FUNCTION_NOT_SPECIFIED"},
+	{podAggregate.ErrInvalidFunctionSpecified, InvalidArgument, "INVALID_FUNCTION_SPECIFIED"},
+	{podAggregate.ErrPodDeleted, FailedPrecondition, "POD_DELETED"},
+
+	// devices
+	{deviceAggregate.ErrCantFitDeviceInRack, FailedPrecondition, "CANT_FIT_DEVICE_IN_RACK"},
+	{deviceAggregate.ErrInvalidDesignationSpecified, InvalidArgument, "INVALID_DESIGNATION_SPECIFIED"},
+	{deviceAggregate.ErrInvalidPlacement, InvalidArgument, "INVALID_PLACEMENT"},
+	{deviceAggregate.ErrFunctionConflict, FailedPrecondition, "FUNCTION_CONFLICT"},
+	{deviceAggregate.ErrDeviceDecommissioned, FailedPrecondition, "DEVICE_DECOMMISSIONED"},
+	{deviceAggregate.ErrDeviceNotRacked, FailedPrecondition, "DEVICE_NOT_RACKED"},
+	{deviceAggregate.ErrDeviceRacked, FailedPrecondition, "DEVICE_RACKED"},
+	{deviceAggregate.ErrDatacenterConflict, FailedPrecondition, "DATACENTER_CONFLICT"},
+	{deviceAggregate.ErrTemplateNotRecorded, FailedPrecondition, "TEMPLATE_NOT_RECORDED"},
+	{datacenter.ErrMissingHostnameTemplateVarValue, FailedPrecondition, "MISSING_HOSTNAME_TEMPLATE_VAR_VALUE"},
+
+	// device templates
+	{deviceTemplateAggregate.ErrModelIdNotProvided, InvalidArgument, "MODEL_ID_NOT_PROVIDED"},
+	{deviceTemplateAggregate.ErrInvalidFunctionSpecified, InvalidArgument, "INVALID_FUNCTION_SPECIFIED"},
+	{deviceTemplateAggregate.ErrInvalidFormFactor, InvalidArgument, "INVALID_FORM_FACTOR"},
+	{deviceTemplateAggregate.ErrInvalidPowerDraw, InvalidArgument, "INVALID_POWER_DRAW"},
+	{deviceTemplateAggregate.ErrInvalidWeight, InvalidArgument, "INVALID_WEIGHT"},
+	{deviceTemplateAggregate.ErrInvalidMounting, InvalidArgument, "INVALID_MOUNTING"},
+	{deviceTemplateAggregate.ErrInvalidPlacement, InvalidArgument, "INVALID_PLACEMENT"},
+
+	// commands and the stores
+	{commands.ErrCommandIdConflict, FailedPrecondition, "COMMAND_ID_CONFLICT"},
+	{events.ErrInvalidCommandType, InvalidArgument, "UNKNOWN_COMMAND"},
+	{events.ErrInvalidCommand, InvalidArgument, "INVALID_COMMAND"},
+	{events.ErrAlreadyExists, AlreadyExists, "ALREADY_EXISTS"},
+	{events.ErrAggregateNotFound, NotFound, "NOT_FOUND"},
+	{projections.ErrProjectionNotFound, NotFound, "NOT_FOUND"},
+	{events.ErrConcurrencyConflict, Aborted, "CONCURRENCY_CONFLICT"},
+	{context.DeadlineExceeded, DeadlineExceeded, "DEADLINE_EXCEEDED"},
+}
+
+// Classify returns the Problem that describes err to a client. errors that aren't classified are internal errors,
+// their message isn't returned since it may leak details of the service.
+func Classify(err error) Problem {
+	for _, c := range classifications {
+		if errors.Is(err, c.err) {
+			return Problem{Kind: c.kind, Code: c.code, Message: err.Error()}
+		}
+	}
+	return Problem{Kind: Internal, Code: "INTERNAL", Message: "internal error"}
+}
@@ -0,0 +1,12 @@
+package api
+
+import "github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+
+// Repositories are the read models queried by the APIs.
+type Repositories struct {
+	Datacenters     projections.DatacenterRepository
+	Racks           projections.RackRepository
+	Pods            projections.PodRepository
+	Devices         projections.DeviceRepository
+	DeviceTemplates projections.DeviceTemplateRepository
+}
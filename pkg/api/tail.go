@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// the number of events read from the log of every stream at a time while tailing it.
+const tailBatchSize = 500
+
+// Tail passes every event saved after the passed position to fn, in order and upcast to its latest version. once it
+// has passed every saved event it calls caughtUp, if it isn't nil, and polls the reader for new ones every
+// pollInterval. it returns when ctx is done, which is not an error, or when reading the log, fn or caughtUp fails.
+func Tail(ctx context.Context, reader events.AllEventsReader, after events.LogPosition, pollInterval time.Duration, fn func(event events.Event) error, caughtUp func() error) error {
+	for {
+		batch, err := reader.ReadAll(ctx, after, tailBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		for _, event := range batch {
+			upcasted, err := events.Upcast(event)
+			if err != nil {
+				return fmt.Errorf("Upcast {%s}: %w", event.GetEventId(), err)
+			}
+			if err = fn(upcasted); err != nil {
+				return err
+			}
+			after = event.GetLogPosition()
+		}
+
+		// a full batch means there are probably more events waiting to be read.
+		if len(batch) == tailBatchSize {
+			continue
+		}
+		if caughtUp != nil {
+			if err = caughtUp(); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
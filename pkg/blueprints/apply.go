@@ -0,0 +1,30 @@
+package blueprints
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// Apply issues the plan's commands, in order, through the passed handler (e.g. a commands.Bus with the v1 handlers
+// registered). a plan with conflicts is not applied.
+// applying stops at the first command that fails, planning again afterwards picks up the remaining changes. the racks
+// and pods created are added to their datacenter by the datacenter membership saga, see sagas.NewDatacenterMembership.
+func (p *Plan) Apply(ctx context.Context, handler events.HandleCommand) error {
+	if len(p.Conflicts) > 0 {
+		var result error
+		for _, conflict := range p.Conflicts {
+			result = multierror.Append(result, fmt.Errorf("%w %s {%s}: %s", ErrUnsupportedChange, conflict.Resource, conflict.Id, conflict.Reason))
+		}
+		return result
+	}
+
+	for _, change := range p.Changes {
+		if err := handler.HandleCommand(ctx, change.Command); err != nil {
+			return fmt.Errorf("%s %s {%s}: %w", change.Action, change.Resource, change.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package blueprints
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"gopkg.in/yaml.v3"
+)
+
+// Blueprint declares the desired state of a datacenter.
+type Blueprint struct {
+	Datacenter      DatacenterSpec       `json:"datacenter" yaml:"datacenter"`
+	DeviceTemplates []DeviceTemplateSpec `json:"deviceTemplates,omitempty" yaml:"deviceTemplates,omitempty"`
+	Racks           []RackSpec           `json:"racks,omitempty" yaml:"racks,omitempty"`
+	Pods            []PodSpec            `json:"pods,omitempty" yaml:"pods,omitempty"`
+	Devices         []DeviceSpec         `json:"devices,omitempty" yaml:"devices,omitempty"`
+}
+
+type DatacenterSpec struct {
+	// the id of the datacenter aggregate. (defaults to the site)
+	ID       string `json:"id,omitempty" yaml:"id,omitempty"`
+	Site     string `json:"site" yaml:"site"`
+	Building string `json:"building,omitempty" yaml:"building,omitempty"`
+	Room     string `json:"room,omitempty" yaml:"room,omitempty"`
+	// the transfer speed of each provider (e.g. '10Gb').
+	Providers map[string]string `json:"providers,omitempty" yaml:"providers,omitempty"`
+}
+
+type DeviceTemplateSpec struct {
+	ID         string `json:"id" yaml:"id"`
+	ModelId    string `json:"modelId" yaml:"modelId"`
+	FormFactor int    `json:"formFactor,omitempty" yaml:"formFactor,omitempty"`
+	// the face of the rack the model is mounted on, 'front' or 'rear', and its depth and width class, 'full' or 'half'.
+	Face  string `json:"face,omitempty" yaml:"face,omitempty"`
+	Depth string `json:"depth,omitempty" yaml:"depth,omitempty"`
+	Width string `json:"width,omitempty" yaml:"width,omitempty"`
+	// the weight of the model (e.g. '18kg' or '40lb').
+	Weight string `json:"weight,omitempty" yaml:"weight,omitempty"`
+	// the typical and the most power drawn by the model, in watts, and its number of power supplies.
+	TypicalPower     int      `json:"typicalPower,omitempty" yaml:"typicalPower,omitempty"`
+	MaxPower         int      `json:"maxPower,omitempty" yaml:"maxPower,omitempty"`
+	PSUs             int      `json:"psus,omitempty" yaml:"psus,omitempty"`
+	Variant          string   `json:"variant,omitempty" yaml:"variant,omitempty"`
+	Categories       []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+	HostnameTemplate string   `json:"hostnameTemplate,omitempty" yaml:"hostnameTemplate,omitempty"`
+	Alias            string   `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Function         string   `json:"function,omitempty" yaml:"function,omitempty"`
+	// how devices created with the template are placed when no elevation is declared, e.g. 'bottom-up'.
+	Placement string `json:"placement,omitempty" yaml:"placement,omitempty"`
+}
+
+type RackSpec struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	// the number of RUs in the rack. (defaults to 45)
+	Size int `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+type PodSpec struct {
+	ID       string `json:"id" yaml:"id"`
+	Function string `json:"function" yaml:"function"`
+}
+
+type DeviceSpec struct {
+	ID string `json:"id" yaml:"id"`
+	// the id of the device template the device is created with.
+	Template  string `json:"template" yaml:"template"`
+	Rack      string `json:"rack" yaml:"rack"`
+	Elevation int    `json:"elevation" yaml:"elevation"`
+	// the id of the pod the device belongs to, if any.
+	Pod         string `json:"pod,omitempty" yaml:"pod,omitempty"`
+	Cluster     int    `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	Designation string `json:"designation,omitempty" yaml:"designation,omitempty"`
+	// how the device is placed when no elevation is declared, the placement of its template if empty.
+	Placement string `json:"placement,omitempty" yaml:"placement,omitempty"`
+}
+
+// DatacenterId returns the id of the datacenter aggregate declared by the blueprint.
+func (b *Blueprint) DatacenterId() string {
+	if b.Datacenter.ID != "" {
+		return b.Datacenter.ID
+	}
+	return strings.ToLower(b.Datacenter.Site)
+}
+
+// Validate returns an error wrapping ErrInvalidBlueprint for every missing field, duplicate id and
+// reference to a resource that isn't declared in the blueprint.
+func (b *Blueprint) Validate() error {
+	var result error
+	invalid := func(format string, args ...any) {
+		result = multierror.Append(result, fmt.Errorf("%w: "+format, append([]any{ErrInvalidBlueprint}, args...)...))
+	}
+
+	if b.Datacenter.Site == "" {
+		invalid("datacenter site not provided")
+	}
+
+	templates := make(map[string]bool)
+	for i, t := range b.DeviceTemplates {
+		switch {
+		case t.ID == "":
+			invalid("deviceTemplates[%d] id not provided", i)
+		case templates[t.ID]:
+			invalid("duplicate device template {%s}", t.ID)
+		}
+		if t.ModelId == "" {
+			invalid("deviceTemplates[%d] modelId not provided", i)
+		}
+		templates[t.ID] = true
+	}
+
+	racks := make(map[string]bool)
+	for i, r := range b.Racks {
+		switch {
+		case r.ID == "":
+			invalid("racks[%d] id not provided", i)
+		case racks[r.ID]:
+			invalid("duplicate rack {%s}", r.ID)
+		}
+		if r.Name == "" {
+			invalid("racks[%d] name not provided", i)
+		}
+		racks[r.ID] = true
+	}
+
+	pods := make(map[string]bool)
+	for i, p := range b.Pods {
+		switch {
+		case p.ID == "":
+			invalid("pods[%d] id not provided", i)
+		case pods[p.ID]:
+			invalid("duplicate pod {%s}", p.ID)
+		}
+		pods[p.ID] = true
+	}
+
+	devices := make(map[string]bool)
+	for i, d := range b.Devices {
+		switch {
+		case d.ID == "":
+			invalid("devices[%d] id not provided", i)
+		case devices[d.ID]:
+			invalid("duplicate device {%s}", d.ID)
+		}
+		devices[d.ID] = true
+
+		if !templates[d.Template] {
+			invalid("device {%s} references undeclared device template {%s}", d.ID, d.Template)
+		}
+		if !racks[d.Rack] {
+			invalid("device {%s} references undeclared rack {%s}", d.ID, d.Rack)
+		}
+		if d.Pod != "" && !pods[d.Pod] {
+			invalid("device {%s} references undeclared pod {%s}", d.ID, d.Pod)
+		}
+	}
+
+	return result
+}
+
+// Format is the encoding of a blueprint.
+type Format string
+
+const (
+	YAML Format = "yaml"
+	JSON Format = "json"
+)
+
+// FormatFromPath returns the format of the blueprint file from its extension.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return YAML, nil
+	case ".json":
+		return JSON, nil
+	}
+	return "", fmt.Errorf("%w {%s}", ErrUnknownFormat, path)
+}
+
+// Load decodes and validates a blueprint. unknown fields are rejected so that typos don't go unnoticed.
+func Load(r io.Reader, format Format) (*Blueprint, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var bp Blueprint
+	switch format {
+	case YAML:
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err = decoder.Decode(&bp); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBlueprint, err)
+		}
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(&bp); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBlueprint, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w {%s}", ErrUnknownFormat, format)
+	}
+
+	if err = bp.Validate(); err != nil {
+		return nil, err
+	}
+	return &bp, nil
+}
+
+// LoadFile loads the blueprint at path, the format is taken from the file's extension.
+func LoadFile(path string) (*Blueprint, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f, format)
+}
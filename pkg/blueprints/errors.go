@@ -0,0 +1,9 @@
+package blueprints
+
+import "errors"
+
+var (
+	ErrInvalidBlueprint  = errors.New("invalid blueprint")
+	ErrUnknownFormat     = errors.New("unknown blueprint format")
+	ErrUnsupportedChange = errors.New("unsupported change")
+)
@@ -0,0 +1,98 @@
+package blueprints
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// Action is what a Change does to a resource.
+type Action string
+
+const (
+	CreateAction Action = "create"
+)
+
+// Symbol returns the prefix used for the action in a printed plan.
+func (a Action) Symbol() string {
+	switch a {
+	case CreateAction:
+		return "+"
+	}
+	return "?"
+}
+
+// Change is a single command that the plan issues when it is applied.
+type Change struct {
+	Action   Action
+	Resource string
+	Id       string
+	// a human readable summary of the command's fields.
+	Details string
+	Command events.Command
+}
+
+// Conflict is a difference between the blueprint and the current state that no command can reconcile.
+type Conflict struct {
+	Resource string
+	Id       string
+	Reason   string
+}
+
+// Plan is the ordered list of changes required to bring the current state in line with a blueprint.
+type Plan struct {
+	Changes   []Change
+	Conflicts []Conflict
+}
+
+// IsEmpty returns true if the plan has neither changes nor conflicts.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0 && len(p.Conflicts) == 0
+}
+
+func (p *Plan) add(action Action, resource, id string, cmd events.Command, details string) {
+	p.Changes = append(p.Changes, Change{Action: action, Resource: resource, Id: id, Details: details, Command: cmd})
+}
+
+func (p *Plan) conflict(resource, id, format string, args ...any) {
+	p.Conflicts = append(p.Conflicts, Conflict{Resource: resource, Id: id, Reason: fmt.Sprintf(format, args...)})
+}
+
+// Write prints the plan in a form similar to 'terraform plan'.
+func (p *Plan) Write(w io.Writer) error {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s %s %q", change.Action.Symbol(), change.Resource, change.Id)
+		if change.Details != "" {
+			fmt.Fprintf(&b, " (%s)", change.Details)
+		}
+		b.WriteString("\n")
+	}
+	for _, conflict := range p.Conflicts {
+		fmt.Fprintf(&b, "  ! %s %q: %s\n", conflict.Resource, conflict.Id, conflict.Reason)
+	}
+
+	if p.IsEmpty() {
+		b.WriteString("No changes. The datacenter matches the blueprint.\n")
+	} else {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Plan: %d to create, %d conflicts.\n", p.count(CreateAction), len(p.Conflicts))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (p *Plan) count(action Action) int {
+	var n int
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,342 @@
+package blueprints
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+const (
+	datacenterResource     = "datacenter"
+	deviceTemplateResource = "device_template"
+	rackResource           = "rack"
+	podResource            = "pod"
+	deviceResource         = "device"
+)
+
+// planner diffs blueprints against the aggregates in the store.
+type planner struct {
+	log   logger.Logger
+	store events.AggregateStore
+}
+
+func NewPlanner(log logger.Logger, store events.AggregateStore) *planner {
+	return &planner{log: log, store: store}
+}
+
+// Plan returns the commands that bring the current state of the datacenter in line with the blueprint.
+// there are no commands to update or remove resources, so resources that exist but differ from the blueprint
+// are reported as conflicts rather than changes.
+// optional fields that are left empty in the blueprint (e.g. a rack's size) are not compared.
+func (p *planner) Plan(ctx context.Context, bp *Blueprint) (*Plan, error) {
+	if err := bp.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	dcId := bp.DatacenterId()
+
+	dc, err := p.planDatacenter(ctx, plan, dcId, bp.Datacenter)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]DeviceTemplateSpec)
+	for _, spec := range bp.DeviceTemplates {
+		templates[spec.ID] = spec
+		if err = p.planDeviceTemplate(ctx, plan, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, spec := range bp.Racks {
+		if err = p.planRack(ctx, plan, dc, spec); err != nil {
+			return nil, err
+		}
+	}
+	// each pod is created and added to the datacenter before the next one is created, since a pod's instance number
+	// is taken from the number of pods with the same function that were added to the datacenter before it.
+	for _, spec := range bp.Pods {
+		if err = p.planPod(ctx, plan, dc, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, spec := range bp.Devices {
+		if err = p.planDevice(ctx, plan, spec, templates[spec.Template]); err != nil {
+			return nil, err
+		}
+	}
+
+	p.log.Debugf("(planner) datacenter: {%s}, changes: {%d}, conflicts: {%d}", dcId, len(plan.Changes), len(plan.Conflicts))
+	return plan, nil
+}
+
+// load loads the aggregate from the store, false is returned if the aggregate doesn't exist.
+func (p *planner) load(ctx context.Context, aggregate events.Aggregate) (bool, error) {
+	err := p.store.Exists(ctx, aggregate.GetId())
+	switch {
+	case errors.Is(err, events.ErrAggregateNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	if err = p.store.Load(ctx, aggregate); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// planDatacenter returns the current state of the datacenter, or an empty datacenter if it doesn't exist yet.
+func (p *planner) planDatacenter(ctx context.Context, plan *Plan, dcId string, spec DatacenterSpec) (*datacenter.Datacenter, error) {
+	dc := datacenterAggregate.NewDatacenterAggregateWithId(dcId)
+	exists, err := p.load(ctx, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		cmd := v1.NewInitDatacenterCommand(dcId, spec.Site, spec.Building, spec.Room, spec.Providers)
+		plan.add(CreateAction, datacenterResource, dcId, cmd, fmt.Sprintf("site=%s building=%s room=%s", spec.Site, spec.Building, spec.Room))
+		dc.Datacenter.ID = dcId
+		return dc.Datacenter, nil
+	}
+
+	differs := func(field, want, got string) {
+		if !strings.EqualFold(want, got) {
+			plan.conflict(datacenterResource, dcId, "%s is {%s}, blueprint declares {%s}", field, got, want)
+		}
+	}
+	differs("site", spec.Site, dc.Datacenter.Site)
+	differs("building", spec.Building, dc.Datacenter.Building)
+	differs("room", spec.Room, dc.Datacenter.Room)
+
+	if len(spec.Providers) != len(dc.Datacenter.Providers) {
+		plan.conflict(datacenterResource, dcId, "has {%d} providers, blueprint declares {%d}", len(dc.Datacenter.Providers), len(spec.Providers))
+	} else {
+		for _, provider := range sortedKeys(spec.Providers) {
+			want, err := units.ParseValue(spec.Providers[provider])
+			if err != nil {
+				return nil, fmt.Errorf("%w: provider {%s}: %v", ErrInvalidBlueprint, provider, err)
+			}
+			got, ok := dc.Datacenter.Providers[strings.ToLower(provider)]
+			if !ok || got.String() != want.String() {
+				plan.conflict(datacenterResource, dcId, "provider {%s} is {%s}, blueprint declares {%s}", provider, got, want)
+			}
+		}
+	}
+
+	return dc.Datacenter, nil
+}
+
+func (p *planner) planDeviceTemplate(ctx context.Context, plan *Plan, spec DeviceTemplateSpec) error {
+	dt := deviceTemplateAggregate.NewDeviceTemplateAggregateWithId(spec.ID)
+	exists, err := p.load(ctx, dt)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		// the command's categories are normalized in place by the aggregate, so the spec's slice isn't shared.
+		categories := append([]string(nil), spec.Categories...)
+		power := hardware.PowerDraw{Typical: spec.TypicalPower, Max: spec.MaxPower, PSUs: spec.PSUs}
+		mounting := hardware.Mounting{Face: hardware.Face(spec.Face), Depth: hardware.Depth(spec.Depth), Width: hardware.Width(spec.Width)}
+		cmd := v1.NewCreateDeviceTemplateCommand(spec.ID, spec.ModelId, spec.FormFactor, mounting, spec.Weight, power, spec.Variant, categories, spec.HostnameTemplate, spec.Alias, spec.Function, spec.Placement)
+		details := "model=" + spec.ModelId
+		if spec.Variant != "" {
+			details += " variant=" + spec.Variant
+		}
+		plan.add(CreateAction, deviceTemplateResource, spec.ID, cmd, details)
+		return nil
+	}
+
+	template := dt.DeviceTemplate
+	if template.Model.ID != spec.ModelId {
+		plan.conflict(deviceTemplateResource, spec.ID, "model is {%s}, blueprint declares {%s}", template.Model.ID, spec.ModelId)
+	}
+	if spec.FormFactor != 0 && template.Model.FormFactor != spec.FormFactor {
+		plan.conflict(deviceTemplateResource, spec.ID, "form factor is {%d}, blueprint declares {%d}", template.Model.FormFactor, spec.FormFactor)
+	}
+	if spec.Face != "" && template.Model.Mounting.MountedFace() != hardware.ParseFace(spec.Face) {
+		plan.conflict(deviceTemplateResource, spec.ID, "face is {%s}, blueprint declares {%s}", template.Model.Mounting.MountedFace(), spec.Face)
+	}
+	if spec.Depth != "" && template.Model.Mounting.IsHalfDepth() != (hardware.ParseDepth(spec.Depth) == hardware.HalfDepth) {
+		plan.conflict(deviceTemplateResource, spec.ID, "depth is {%s}, blueprint declares {%s}", template.Model.Mounting.Depth, spec.Depth)
+	}
+	if spec.Width != "" && template.Model.Mounting.IsHalfWidth() != (hardware.ParseWidth(spec.Width) == hardware.HalfWidth) {
+		plan.conflict(deviceTemplateResource, spec.ID, "width is {%s}, blueprint declares {%s}", template.Model.Mounting.Width, spec.Width)
+	}
+	if spec.Weight != "" && !sameWeight(template.Model.Weight, spec.Weight) {
+		plan.conflict(deviceTemplateResource, spec.ID, "weight is {%s}, blueprint declares {%s}", template.Model.Weight, spec.Weight)
+	}
+	if spec.TypicalPower != 0 && template.Model.Power.Typical != spec.TypicalPower {
+		plan.conflict(deviceTemplateResource, spec.ID, "typical power is {%dW}, blueprint declares {%dW}", template.Model.Power.Typical, spec.TypicalPower)
+	}
+	if spec.MaxPower != 0 && template.Model.Power.Max != spec.MaxPower {
+		plan.conflict(deviceTemplateResource, spec.ID, "max power is {%dW}, blueprint declares {%dW}", template.Model.Power.Max, spec.MaxPower)
+	}
+	if spec.PSUs != 0 && template.Model.Power.PSUs != spec.PSUs {
+		plan.conflict(deviceTemplateResource, spec.ID, "psus are {%d}, blueprint declares {%d}", template.Model.Power.PSUs, spec.PSUs)
+	}
+	if spec.Variant != "" && !strings.EqualFold(template.Variant, spec.Variant) {
+		plan.conflict(deviceTemplateResource, spec.ID, "variant is {%s}, blueprint declares {%s}", template.Variant, spec.Variant)
+	}
+	if len(spec.Categories) > 0 && !equalFold(template.Categories, spec.Categories) {
+		plan.conflict(deviceTemplateResource, spec.ID, "categories are {%s}, blueprint declares {%s}", strings.Join(template.Categories, ","), strings.Join(spec.Categories, ","))
+	}
+	if template.HostnameTemplate != spec.HostnameTemplate {
+		plan.conflict(deviceTemplateResource, spec.ID, "hostname template is {%s}, blueprint declares {%s}", template.HostnameTemplate, spec.HostnameTemplate)
+	}
+	if !strings.EqualFold(template.Alias, spec.Alias) {
+		plan.conflict(deviceTemplateResource, spec.ID, "alias is {%s}, blueprint declares {%s}", template.Alias, spec.Alias)
+	}
+	if spec.Function != "" && template.Function != datacenter.ParseFunction(spec.Function) {
+		plan.conflict(deviceTemplateResource, spec.ID, "function is {%s}, blueprint declares {%s}", template.Function, spec.Function)
+	}
+	if spec.Placement != "" && template.Placement != datacenter.ParsePlacement(spec.Placement) {
+		plan.conflict(deviceTemplateResource, spec.ID, "placement is {%s}, blueprint declares {%s}", template.Placement, spec.Placement)
+	}
+	return nil
+}
+
+func (p *planner) planRack(ctx context.Context, plan *Plan, dc *datacenter.Datacenter, spec RackSpec) error {
+	rack := rackAggregate.NewRackAggregateWithId(spec.ID)
+	exists, err := p.load(ctx, rack)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		cmd := v1.NewCreateRackCommand(spec.ID, spec.Name, spec.Size, dc.ID)
+		plan.add(CreateAction, rackResource, spec.ID, cmd, fmt.Sprintf("name=%s size=%d", spec.Name, spec.Size))
+	} else {
+		switch {
+		case rack.IsDeleted():
+			plan.conflict(rackResource, spec.ID, "rack has been deleted")
+			return nil
+		case rack.Rack.Datacenter.ID != dc.ID:
+			plan.conflict(rackResource, spec.ID, "rack belongs to datacenter {%s}", rack.Rack.Datacenter.ID)
+			return nil
+		}
+		if !strings.EqualFold(rack.Rack.Name, spec.Name) {
+			plan.conflict(rackResource, spec.ID, "name is {%s}, blueprint declares {%s}", rack.Rack.Name, spec.Name)
+		}
+		if spec.Size != 0 && rack.Rack.Size != spec.Size {
+			plan.conflict(rackResource, spec.ID, "size is {%d}, blueprint declares {%d}", rack.Rack.Size, spec.Size)
+		}
+	}
+	return nil
+}
+
+func (p *planner) planPod(ctx context.Context, plan *Plan, dc *datacenter.Datacenter, spec PodSpec) error {
+	pod := podAggregate.NewPodAggregateWithId(spec.ID)
+	exists, err := p.load(ctx, pod)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		cmd := v1.NewCreatePodCommand(spec.ID, spec.Function, dc.ID)
+		plan.add(CreateAction, podResource, spec.ID, cmd, "function="+spec.Function)
+	} else {
+		switch {
+		case pod.IsDeleted():
+			plan.conflict(podResource, spec.ID, "pod has been deleted")
+			return nil
+		case pod.Pod.Datacenter.ID != dc.ID:
+			plan.conflict(podResource, spec.ID, "pod belongs to datacenter {%s}", pod.Pod.Datacenter.ID)
+			return nil
+		}
+		if pod.Pod.Function != datacenter.ParseFunction(spec.Function) {
+			plan.conflict(podResource, spec.ID, "function is {%s}, blueprint declares {%s}", pod.Pod.Function, spec.Function)
+		}
+	}
+	return nil
+}
+
+func (p *planner) planDevice(ctx context.Context, plan *Plan, spec DeviceSpec, template DeviceTemplateSpec) error {
+	device := deviceAggregate.NewDeviceAggregateWithId(spec.ID)
+	exists, err := p.load(ctx, device)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		cmd := v1.NewCreateDeviceCommand(spec.ID, spec.Template, spec.Elevation, spec.Rack, spec.Cluster, spec.Designation, spec.Pod, spec.Placement)
+		plan.add(CreateAction, deviceResource, spec.ID, cmd, fmt.Sprintf("template=%s rack=%s elevation=%d", spec.Template, spec.Rack, spec.Elevation))
+		return nil
+	}
+
+	d := device.Device
+	if d.Model.ID != template.ModelId {
+		plan.conflict(deviceResource, spec.ID, "model is {%s}, template {%s} declares {%s}", d.Model.ID, spec.Template, template.ModelId)
+	}
+	if d.Rack == nil || d.Rack.ID != spec.Rack {
+		plan.conflict(deviceResource, spec.ID, "device is not racked in {%s}", spec.Rack)
+	} else if spec.Elevation != 0 && d.Elevation != spec.Elevation {
+		plan.conflict(deviceResource, spec.ID, "elevation is {%d}, blueprint declares {%d}", d.Elevation, spec.Elevation)
+	}
+
+	var podId string
+	if d.Pod != nil {
+		podId = d.Pod.ID
+	}
+	if podId != spec.Pod {
+		plan.conflict(deviceResource, spec.ID, "pod is {%s}, blueprint declares {%s}", podId, spec.Pod)
+	}
+	if d.Cluster != spec.Cluster {
+		plan.conflict(deviceResource, spec.ID, "cluster is {%d}, blueprint declares {%d}", d.Cluster, spec.Cluster)
+	}
+	if spec.Designation != "" && d.Designation != datacenter.ParseDesignation(spec.Designation) {
+		plan.conflict(deviceResource, spec.ID, "designation is {%s}, blueprint declares {%s}", d.Designation, spec.Designation)
+	}
+	return nil
+}
+
+// sameWeight returns true if the weight declared by a blueprint is the weight, in any unit of mass.
+func sameWeight(weight units.Value, declared string) bool {
+	value, err := units.ParseMass(declared)
+	if err != nil {
+		return false
+	}
+	a, _ := weight.In(units.Gram)
+	b, _ := value.In(units.Gram)
+	return math.Round(a) == math.Round(b)
+}
+
+func equalFold(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
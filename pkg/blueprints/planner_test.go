@@ -0,0 +1,87 @@
+package blueprints
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+const testBlueprint = `
+datacenter:
+  site: DAL1
+  providers:
+    zayo: 10Gb
+deviceTemplates:
+  - id: sw
+    modelId: N9K-C93180YC-FX
+    formFactor: 1
+    weight: 8.2kg
+    typicalPower: 250
+    categories: [network]
+    hostnameTemplate: '{{.Site}}-sw{{.Number}}'
+  - id: srv
+    modelId: R650
+    categories: [compute]
+    hostnameTemplate: '{{.Site}}-srv{{.Number}}'
+    placement: bottom-up
+racks:
+  - id: a01
+    name: A01
+    size: 42
+pods:
+  - id: p1
+    function: compute
+devices:
+  - id: sw1
+    template: sw
+    rack: a01
+    elevation: 42
+    pod: p1
+  - id: srv1
+    template: srv
+    rack: a01
+    pod: p1
+`
+
+func TestPlanApplyAgain(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+	bus := commands.NewBus(commands.Validation())
+	if err := v1.RegisterHandlers(bus, store, log); err != nil {
+		t.Fatalf("RegisterHandlers: %v", err)
+	}
+
+	bp, err := Load(strings.NewReader(testBlueprint), YAML)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	planner := NewPlanner(log, store)
+
+	plan, err := planner.Plan(ctx, bp)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	// the datacenter, 2 templates, the rack, the pod and 2 devices are created.
+	if len(plan.Changes) != 7 || len(plan.Conflicts) != 0 {
+		t.Fatalf("expected 7 changes, got %+v", plan)
+	}
+	if err = plan.Apply(ctx, bus); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	again, err := planner.Plan(ctx, bp)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if !again.IsEmpty() {
+		var b strings.Builder
+		_ = again.Write(&b)
+		t.Fatalf("expected an empty plan after applying the blueprint, got\n%s", b.String())
+	}
+}
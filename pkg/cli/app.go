@@ -0,0 +1,303 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/api/httpapi"
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/filedb"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+	"github.com/malijoe/DatacenterGenerator/pkg/sagas"
+)
+
+// commandIdTTL is how long the ids of processed commands are remembered, a command replayed with the same id within it
+// isn't handled again.
+const commandIdTTL = 24 * time.Hour
+
+const (
+	// the directory, within the design directory, the state of the sagas is kept in.
+	stateDir = ".state"
+	// the name the checkpoint of the sagas is saved under.
+	sagaRunnerName = "sagas"
+)
+
+// store is implemented by every event store backend.
+type store interface {
+	events.AggregateStore
+	events.EventStore
+	events.AllEventsReader
+}
+
+// snapshotStore is a store whose racks and datacenters are loaded from their latest snapshot and the events that
+// followed it, the snapshots are saved as events of the store.
+type snapshotStore struct {
+	store
+	aggregates events.AggregateStore
+}
+
+func newSnapshotStore(log logger.Logger, s store, frequency int64) *snapshotStore {
+	aggregates := eventstore.NewSnapshotAggregateStore(log, s, eventstore.NewStreamSnapshotStore(s), frequency,
+		rackAggregate.NewSnapshotSerializer(), datacenterAggregate.NewSnapshotSerializer())
+	return &snapshotStore{store: s, aggregates: aggregates}
+}
+
+func (s *snapshotStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	return s.aggregates.Load(ctx, aggregate)
+}
+
+func (s *snapshotStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	return s.aggregates.Save(ctx, aggregate)
+}
+
+func (s *snapshotStore) Exists(ctx context.Context, streamId string) error {
+	return s.aggregates.Exists(ctx, streamId)
+}
+
+// app holds the dependencies of a running command.
+type app struct {
+	log   logger.Logger
+	opts  *options
+	store store
+	bus   *commands.Bus
+	// the metrics of the commands dispatched through the bus.
+	metrics httpapi.CommandMetricsSource
+	// where the sagas keep their state and the position of the last event they handled, see sagaRunner.
+	sagaStates  sagas.StateStore
+	checkpoints projectors.CheckpointStore
+	// the ids of the commands processed through the bus, see commands.Idempotency.
+	dedup  commands.DedupStore
+	stdout io.Writer
+	// closes the store.
+	close func() error
+}
+
+func newApp(ctx context.Context, opts *options, stdout, stderr io.Writer) (*app, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	log := logger.NewLogger("dcgen")
+	log.SetOutput(stderr)
+	if err := logger.ApplyOptionsToLoggers(&opts.log); err != nil {
+		return nil, err
+	}
+
+	a := &app{log: log, opts: opts, stdout: stdout, close: func() error { return nil }}
+	switch opts.store {
+	case memoryStore:
+		a.store = eventstore.NewMemoryStore()
+	case fileStore:
+		s, err := eventstore.NewFileStore(log, opts.dir)
+		if err != nil {
+			return nil, err
+		}
+		a.store, a.close = s, s.Close
+	case esdbStore:
+		db, err := eventstore.NewEventStoreDBConn(&eventstore.Config{ConnectionString: opts.connectionString})
+		if err != nil {
+			return nil, err
+		}
+		a.store, a.close = eventstore.NewEsdbStore(log, eventstore.NewEsdbClient(db)), db.Close
+	}
+	if opts.snapshotFrequency > 0 {
+		a.store = newSnapshotStore(log, a.store, opts.snapshotFrequency)
+	}
+
+	if err := a.openState(); err != nil {
+		a.close()
+		return nil, err
+	}
+
+	metrics := commands.NewMemoryMetrics()
+	a.metrics = metrics
+	// the deadline is within the metrics and logging, so a command that times out is recorded as failed.
+	middleware := []commands.Middleware{commands.Validation(), commands.Logging(log), commands.Metrics(metrics)}
+	if opts.commandTimeout > 0 {
+		middleware = append(middleware, commands.Deadline(opts.commandTimeout))
+	}
+	middleware = append(middleware, commands.Idempotency(log, a.dedup, commandIdTTL))
+	a.bus = commands.NewBus(middleware...)
+	if err := v1.RegisterHandlers(a.bus, a.store, log); err != nil {
+		a.close()
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// openState opens the stores the sagas keep their state in, and the store of the ids of processed commands. the state
+// is kept in the design directory, unless the event store is discarded when the command exits.
+func (a *app) openState() error {
+	if a.opts.store == memoryStore {
+		a.sagaStates, a.checkpoints = sagas.NewMemoryStateStore(), projectors.NewMemoryCheckpointStore()
+		a.dedup = commands.NewMemoryDedupStore()
+		return nil
+	}
+
+	db, err := filedb.NewDatabase(filepath.Join(a.opts.dir, stateDir))
+	if err != nil {
+		return err
+	}
+	if a.sagaStates, err = filedb.NewSagaStateStore(db); err != nil {
+		return err
+	}
+	if a.checkpoints, err = filedb.NewCheckpointStore(db); err != nil {
+		return err
+	}
+	a.dedup, err = filedb.NewDedupStore(db)
+	return err
+}
+
+// sagaRunner returns a runner that feeds the events of the store to the sagas, e.g. the saga that adds racks and pods
+// to their datacenter once they have been created.
+func (a *app) sagaRunner(opts ...projectors.RunnerOption) *projectors.Runner {
+	return projectors.NewRunner(a.log, a.store, a.checkpoints, []projectors.Projector{
+		sagas.NewDatacenterMembership(a.log, a.store, a.sagaStates),
+	}, append([]projectors.RunnerOption{projectors.WithName(sagaRunnerName)}, opts...)...)
+}
+
+// runSagas waits for the sagas to handle every event saved so far, for the commands whose changes are completed by a
+// saga.
+func (a *app) runSagas(ctx context.Context) error {
+	return a.sagaRunner().CatchUp(ctx)
+}
+
+// runFunc runs a command with the arguments left after its flags were parsed.
+type runFunc func(ctx context.Context, a *app, args []string) error
+
+type command struct {
+	name    string
+	summary string
+	// setup defines the command's flags and returns the function that runs it.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// registry holds the commands of the tool, indexed by their name.
+var registry = newRegistry(
+	initDatacenterCmd(),
+	createRackCmd(),
+	configureRackPowerCmd(),
+	configureRackLoadCmd(),
+	reserveRUsCmd(),
+	releaseRUsCmd(),
+	createPodCmd(),
+	createTemplateCmd(),
+	createDeviceCmd(),
+	moveDeviceCmd(),
+	unrackDeviceCmd(),
+	decommissionDeviceCmd(),
+	showCmd(),
+	powerReportCmd(),
+	eventsCmd(),
+	planCmd(),
+	applyCmd(),
+	serveCmd(),
+)
+
+func newRegistry(cmds ...command) map[string]command {
+	registry := make(map[string]command, len(cmds))
+	for _, cmd := range cmds {
+		registry[cmd.name] = cmd
+	}
+	return registry
+}
+
+// Run runs the dcgen command line with the passed arguments (excluding the program name) and returns the
+// process' exit code.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	cmd, ok := registry[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "%v {%s}\n\n", ErrUnknownCommand, args[0])
+		usage(stderr)
+		return 2
+	}
+
+	fs := flag.NewFlagSet("dcgen "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts := &options{}
+	opts.attachCmdFlags(fs)
+	run := cmd.setup(fs)
+	if err := fs.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	a, err := newApp(ctx, opts, stdout, stderr)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	defer func() {
+		if err := a.close(); err != nil {
+			a.log.Warnf("(dcgen) failed to close the store: %v", err)
+		}
+	}()
+
+	if err = run(ctx, a, fs.Args()); err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		if errors.Is(err, ErrUsage) {
+			return 2
+		}
+		return 1
+	}
+	return 0
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("usage: dcgen <command> [flags]\n\ncommands:\n")
+	tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, name := range names {
+		fmt.Fprintf(tw, "  %s\t%s\n", name, registry[name].summary)
+	}
+	tw.Flush()
+	b.WriteString("\nrun 'dcgen <command> -h' for the flags of a command.\n")
+	io.WriteString(w, b.String())
+}
+
+// required returns an error wrapping ErrUsage for the first flag that wasn't provided.
+func required(flags map[string]string) error {
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if flags[name] == "" {
+			return fmt.Errorf("%w: --%s is required", ErrUsage, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// newMemoryApp returns an app over a memory store, with the defaults of the flags of every command.
+func newMemoryApp(t *testing.T) *app {
+	t.Helper()
+	return newTestApp(t, "--store", memoryStore)
+}
+
+// newTestApp returns an app with the passed flags, and the defaults of the flags of every command.
+func newTestApp(t *testing.T, args ...string) *app {
+	t.Helper()
+
+	opts := &options{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	opts.attachCmdFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	a, err := newApp(context.Background(), opts, io.Discard, io.Discard)
+	if err != nil {
+		t.Fatalf("newApp: %v", err)
+	}
+	return a
+}
+
+func TestAppReplayedCommand(t *testing.T) {
+	ctx := context.Background()
+	a := newMemoryApp(t)
+	defer a.close()
+
+	err := a.bus.HandleCommand(ctx, v1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil))
+	if err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+
+	// the automation retries the command after its response was lost.
+	for attempt := 1; attempt <= 2; attempt++ {
+		cmd := v1.NewCreatePodCommand("p1", "compute", "dc1")
+		cmd.SetCommandId("create-p1")
+		if err = a.bus.HandleCommand(ctx, cmd); err != nil {
+			t.Fatalf("CreatePod (attempt %d): %v", attempt, err)
+		}
+	}
+
+	pod, err := podAggregate.LoadPodAggregate(ctx, a.store, "p1")
+	if err != nil {
+		t.Fatalf("LoadPodAggregate: %v", err)
+	}
+	if pod.Pod.Instance != 1 || pod.GetVersion() != 0 {
+		t.Fatalf("expected pod instance 1 at version 0, got instance %d at version %d", pod.Pod.Instance, pod.GetVersion())
+	}
+
+	evts, err := a.store.ReadAll(ctx, events.LogPosition{}, 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	created := 0
+	for _, event := range evts {
+		if event.GetEventType() == eventsv1.PodCreated {
+			created++
+		}
+	}
+	if created != 1 {
+		t.Fatalf("expected the pod to be created once, got %d events", created)
+	}
+
+	// a command with another id is handled, and rejected.
+	cmd := v1.NewCreatePodCommand("p1", "compute", "dc1")
+	cmd.SetCommandId("create-p1-again")
+	if err = a.bus.HandleCommand(ctx, cmd); !errors.Is(err, events.ErrAlreadyExists) {
+		t.Fatalf("expected %v, got %v", events.ErrAlreadyExists, err)
+	}
+}
+
+func TestAppReplayedCommandAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	a := newTestApp(t, "--store", fileStore, "--dir", dir)
+
+	if err := a.bus.HandleCommand(ctx, v1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil)); err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	cmd := v1.NewCreatePodCommand("p1", "compute", "dc1")
+	cmd.SetCommandId("create-p1")
+	if err := a.bus.HandleCommand(ctx, cmd); err != nil {
+		t.Fatalf("CreatePod: %v", err)
+	}
+	a.close()
+
+	// the command is replayed to another dcgen process, e.g. after serve was restarted.
+	a = newTestApp(t, "--store", fileStore, "--dir", dir)
+	defer a.close()
+	cmd = v1.NewCreatePodCommand("p1", "compute", "dc1")
+	cmd.SetCommandId("create-p1")
+	if err := a.bus.HandleCommand(ctx, cmd); err != nil {
+		t.Fatalf("CreatePod (replayed): %v", err)
+	}
+
+	evts, err := a.store.LoadEvents(ctx, podAggregate.NewPodAggregateWithId("p1").GetId())
+	if err != nil {
+		t.Fatalf("LoadEvents: %v", err)
+	}
+	created := 0
+	for _, event := range evts {
+		if event.GetEventType() == eventsv1.PodCreated {
+			created++
+		}
+	}
+	if created != 1 {
+		t.Fatalf("expected the pod to be created once, got %d events", created)
+	}
+}
+
+func TestAppAddsCreatedRackToDatacenter(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	a := newTestApp(t, "--store", fileStore, "--dir", dir)
+
+	if err := a.bus.HandleCommand(ctx, v1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil)); err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	if err := a.bus.HandleCommand(ctx, v1.NewCreateRackCommand("r1", "a01", 42, "dc1")); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if err := a.runSagas(ctx); err != nil {
+		t.Fatalf("runSagas: %v", err)
+	}
+	a.close()
+
+	// the sagas resume after the events they handled, the rack isn't added again.
+	a = newTestApp(t, "--store", fileStore, "--dir", dir)
+	defer a.close()
+	if err := a.runSagas(ctx); err != nil {
+		t.Fatalf("runSagas: %v", err)
+	}
+
+	evts, err := a.store.LoadEvents(ctx, datacenterAggregate.NewDatacenterAggregateWithId("dc1").GetId())
+	if err != nil {
+		t.Fatalf("LoadEvents: %v", err)
+	}
+	added := 0
+	for _, event := range evts {
+		if event.GetEventType() == eventsv1.DatacenterRackAdded {
+			added++
+		}
+	}
+	if added != 1 {
+		t.Fatalf("expected the rack to be added to the datacenter once, got %d events", added)
+	}
+}
+
+func TestAppCommandTimeout(t *testing.T) {
+	opts := &options{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	opts.attachCmdFlags(fs)
+	if err := fs.Parse([]string{"--store", memoryStore, "--command-timeout", "-1s"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if _, err := newApp(context.Background(), opts, io.Discard, io.Discard); !errors.Is(err, ErrInvalidCommandTimeout) {
+		t.Fatalf("expected %v, got %v", ErrInvalidCommandTimeout, err)
+	}
+
+	// a command whose deadline has passed is rejected by the bus, before it is handled.
+	a := newTestApp(t, "--store", memoryStore, "--command-timeout", "1m")
+	defer a.close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := a.bus.HandleCommand(ctx, v1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if err := a.store.Exists(context.Background(), datacenterAggregate.NewDatacenterAggregateWithId("dc1").GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+}
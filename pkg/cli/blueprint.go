@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/blueprints"
+)
+
+func planCmd() command {
+	return command{
+		name:    "plan",
+		summary: "show the changes that bring a datacenter in line with a blueprint",
+		setup: func(fs *flag.FlagSet) runFunc {
+			fs.Usage = func() {
+				fmt.Fprintf(fs.Output(), "usage: dcgen plan <blueprint.yaml|blueprint.json> [flags]\n")
+				fs.PrintDefaults()
+			}
+
+			return func(ctx context.Context, a *app, args []string) error {
+				plan, err := a.plan(ctx, args)
+				if err != nil {
+					return err
+				}
+				return plan.Write(a.stdout)
+			}
+		},
+	}
+}
+
+func applyCmd() command {
+	return command{
+		name:    "apply",
+		summary: "apply the changes that bring a datacenter in line with a blueprint",
+		setup: func(fs *flag.FlagSet) runFunc {
+			fs.Usage = func() {
+				fmt.Fprintf(fs.Output(), "usage: dcgen apply <blueprint.yaml|blueprint.json> [flags]\n")
+				fs.PrintDefaults()
+			}
+
+			return func(ctx context.Context, a *app, args []string) error {
+				plan, err := a.plan(ctx, args)
+				if err != nil {
+					return err
+				}
+				if err = plan.Write(a.stdout); err != nil {
+					return err
+				}
+				if plan.IsEmpty() {
+					return nil
+				}
+
+				if err = plan.Apply(ctx, a.bus); err != nil {
+					return err
+				}
+				// the racks and pods created are added to the datacenter by the datacenter membership saga.
+				if err = a.runSagas(ctx); err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(a.stdout, "Apply complete: %d changes applied.\n", len(plan.Changes))
+				return err
+			}
+		},
+	}
+}
+
+// plan loads the blueprint passed as the only argument and plans it against the store.
+func (a *app) plan(ctx context.Context, args []string) (*blueprints.Plan, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected a blueprint file", ErrUsage)
+	}
+
+	bp, err := blueprints.LoadFile(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return blueprints.NewPlanner(a.log, a.store).Plan(ctx, bp)
+}
@@ -0,0 +1,532 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+func initDatacenterCmd() command {
+	return command{
+		name:    "init-datacenter",
+		summary: "create a datacenter",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, site, building, room string
+				providers                = keyValueFlag{}
+			)
+			fs.StringVar(&id, "id", "", "the id of the datacenter (defaults to the site)")
+			fs.StringVar(&site, "site", "", "the site name of the datacenter (required)")
+			fs.StringVar(&building, "building", "", "the building where the datacenter is located")
+			fs.StringVar(&room, "room", "", "the room of the building where the datacenter is located")
+			fs.Var(providers, "provider", "a provider and its transfer speed, e.g. zayo=10Gb (repeatable)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"site": site}); err != nil {
+					return err
+				}
+				if id == "" {
+					id = strings.ToLower(site)
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewInitDatacenterCommand(id, site, building, room, providers)); err != nil {
+					return err
+				}
+				return a.show(ctx, datacenterResource, id)
+			}
+		},
+	}
+}
+
+func createRackCmd() command {
+	return command{
+		name:    "create-rack",
+		summary: "create a rack and add it to its datacenter",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, name, datacenterId string
+				size                   int
+			)
+			fs.StringVar(&id, "id", "", "the id of the rack (defaults to the name)")
+			fs.StringVar(&name, "name", "", "the name of the rack (required)")
+			fs.IntVar(&size, "size", 0, "the number of RUs in the rack (default 45)")
+			fs.StringVar(&datacenterId, "datacenter", "", "the id of the datacenter the rack belongs to (required)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"name": name, "datacenter": datacenterId}); err != nil {
+					return err
+				}
+				if id == "" {
+					id = strings.ToLower(name)
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewCreateRackCommand(id, name, size, datacenterId)); err != nil {
+					return err
+				}
+				// the rack is added to its datacenter by the datacenter membership saga.
+				if err := a.runSagas(ctx); err != nil {
+					return err
+				}
+				return a.show(ctx, rackResource, id)
+			}
+		},
+	}
+}
+
+func configureRackPowerCmd() command {
+	return command{
+		name:    "configure-rack-power",
+		summary: "set the power feeds of a rack and how devices over their budget are handled",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, policy string
+				derating   float64
+				feeds      powerFeedFlag
+			)
+			fs.StringVar(&id, "rack", "", "the id of the rack (required)")
+			fs.Var(&feeds, "feed", "a feed of the rack as name:amperage:voltage[:phases], e.g. A:30:208 (repeatable, required)")
+			fs.Float64Var(&derating, "derating", 0, "the fraction of the capacity of a feed that may be drawn from it (default 0.8)")
+			fs.StringVar(&policy, "policy", "", "what racking a device that pushes a feed over its budget does: reject or warn (default reject)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"rack": id, "feed": feeds.String()}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewConfigureRackPowerCommand(id, feeds, derating, policy)); err != nil {
+					return err
+				}
+				return a.show(ctx, rackResource, id)
+			}
+		},
+	}
+}
+
+func configureRackLoadCmd() command {
+	return command{
+		name:    "configure-rack-load",
+		summary: "set the weight a rack is rated to bear and how high heavy devices may be racked",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, static, dynamic, heavyWeight string
+				heavyMaxElevation                int
+			)
+			fs.StringVar(&id, "rack", "", "the id of the rack (required)")
+			fs.StringVar(&static, "static", "", "the weight the rack bears standing, e.g. 1000kg or 2200lb")
+			fs.StringVar(&dynamic, "dynamic", "", "the weight the rack bears rolled on its casters, e.g. 800kg")
+			fs.StringVar(&heavyWeight, "heavy-weight", "", "the weight from which a device is heavy, e.g. 40kg")
+			fs.IntVar(&heavyMaxElevation, "heavy-max-elevation", 0, "the highest elevation a heavy device may be racked at")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"rack": id}); err != nil {
+					return err
+				}
+
+				cmd := v1.NewConfigureRackLoadCommand(id, static, dynamic, heavyWeight, heavyMaxElevation)
+				if err := a.bus.HandleCommand(ctx, cmd); err != nil {
+					return err
+				}
+				return a.show(ctx, rackResource, id)
+			}
+		},
+	}
+}
+
+func reserveRUsCmd() command {
+	return command{
+		name:    "reserve-rus",
+		summary: "reserve a range of RU(s) of a rack so devices aren't racked in them automatically",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, rus, reason string
+				categories      listFlag
+			)
+			fs.StringVar(&id, "rack", "", "the id of the rack (required)")
+			fs.StringVar(&rus, "rus", "", "the RU(s) to reserve, e.g. 42-45 or 1,2 (required)")
+			fs.StringVar(&reason, "reason", "", "why the RU(s) are reserved")
+			fs.Var(&categories, "category", "a category of the devices that may be racked in the RU(s) automatically (repeatable)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"rack": id, "rus": rus}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewReserveRUsCommand(id, rus, reason, categories)); err != nil {
+					return err
+				}
+				return a.show(ctx, rackResource, id)
+			}
+		},
+	}
+}
+
+func releaseRUsCmd() command {
+	return command{
+		name:    "release-rus",
+		summary: "release a range of reserved RU(s) of a rack",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var id, rus, reason string
+			fs.StringVar(&id, "rack", "", "the id of the rack (required)")
+			fs.StringVar(&rus, "rus", "", "the RU(s) as they were reserved, e.g. 42-45 (required)")
+			fs.StringVar(&reason, "reason", "", "why the RU(s) are released")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"rack": id, "rus": rus}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewReleaseRUsCommand(id, rus, reason)); err != nil {
+					return err
+				}
+				return a.show(ctx, rackResource, id)
+			}
+		},
+	}
+}
+
+func createPodCmd() command {
+	return command{
+		name:    "create-pod",
+		summary: "create a pod and add it to its datacenter",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var id, function, datacenterId string
+			fs.StringVar(&id, "id", "", "the id of the pod (required)")
+			fs.StringVar(&function, "function", "", "the function of the pod: compute, edge, service or storage (required)")
+			fs.StringVar(&datacenterId, "datacenter", "", "the id of the datacenter the pod belongs to (required)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id, "function": function, "datacenter": datacenterId}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewCreatePodCommand(id, function, datacenterId)); err != nil {
+					return err
+				}
+				// the pod is added to its datacenter by the datacenter membership saga.
+				if err := a.runSagas(ctx); err != nil {
+					return err
+				}
+				return a.show(ctx, podResource, id)
+			}
+		},
+	}
+}
+
+// placements lists the placements of devices the commands accept.
+const placements = "top-down, bottom-up, best-fit, pair-adjacent or balanced"
+
+func createTemplateCmd() command {
+	return command{
+		name:    "create-template",
+		summary: "create a device template",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, modelId, face, depth, width, weight, variant, hostnameTemplate, alias, function, placement string
+				formFactor, typicalPower, maxPower, psus                                                       int
+				categories                                                                                     listFlag
+			)
+			fs.StringVar(&id, "id", "", "the id of the device template (required)")
+			fs.StringVar(&modelId, "model", "", "the id of the hardware model (required)")
+			fs.IntVar(&formFactor, "form-factor", 0, "the number of RUs the model occupies (default 1)")
+			fs.StringVar(&face, "face", "", "the face of the rack the model is mounted on: front or rear (default front)")
+			fs.StringVar(&depth, "depth", "", "the depth class of the model, a half depth model only occupies its face: full or half (default full)")
+			fs.StringVar(&width, "width", "", "the width class of the model, two half width models share an RU: full or half (default full)")
+			fs.StringVar(&weight, "weight", "", "the weight of the model, e.g. 18kg or 40lb")
+			fs.IntVar(&typicalPower, "typical-power", 0, "the power the model draws under a typical load, in watts")
+			fs.IntVar(&maxPower, "max-power", 0, "the most power the model draws, in watts (default: the typical power)")
+			fs.IntVar(&psus, "psus", 0, "the number of power supplies of the model (default 1)")
+			fs.StringVar(&variant, "variant", "", "the variant of the hardware model (default 'default')")
+			fs.Var(&categories, "category", "a category of the devices created with the template (repeatable)")
+			fs.StringVar(&hostnameTemplate, "hostname-template", "", "the template of the hostnames of the devices created with the template")
+			fs.StringVar(&alias, "alias", "", "an alias used to reference the template")
+			fs.StringVar(&function, "function", "", "the function of the devices created with the template")
+			fs.StringVar(&placement, "placement", "", "how devices created with the template are placed when no elevation is given: "+placements+" (default top-down)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id, "model": modelId}); err != nil {
+					return err
+				}
+
+				power := hardware.PowerDraw{Typical: typicalPower, Max: maxPower, PSUs: psus}
+				mounting := hardware.Mounting{Face: hardware.Face(face), Depth: hardware.Depth(depth), Width: hardware.Width(width)}
+				cmd := v1.NewCreateDeviceTemplateCommand(id, modelId, formFactor, mounting, weight, power, variant, categories, hostnameTemplate, alias, function, placement)
+				if err := a.bus.HandleCommand(ctx, cmd); err != nil {
+					return err
+				}
+				return a.show(ctx, deviceTemplateResource, id)
+			}
+		},
+	}
+}
+
+func createDeviceCmd() command {
+	return command{
+		name:    "create-device",
+		summary: "create a device and rack it",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, templateId, rackId, podId, designation, placement string
+				elevation, cluster                                    int
+			)
+			fs.StringVar(&id, "id", "", "the id of the device (required)")
+			fs.StringVar(&templateId, "template", "", "the id of the device template (required)")
+			fs.StringVar(&rackId, "rack", "", "the id of the rack the device is racked in (required)")
+			fs.IntVar(&elevation, "elevation", 0, "the highest RU the device occupies (default: placed by the placement)")
+			fs.StringVar(&podId, "pod", "", "the id of the pod the device belongs to")
+			fs.IntVar(&cluster, "cluster", 0, "the cluster number of the device (0 is unclustered)")
+			fs.StringVar(&designation, "designation", "", "the designation of the device: primary or secondary")
+			fs.StringVar(&placement, "placement", "", "how the device is placed when no elevation is given: "+placements+" (default: the placement of the template)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id, "template": templateId, "rack": rackId}); err != nil {
+					return err
+				}
+
+				cmd := v1.NewCreateDeviceCommand(id, templateId, elevation, rackId, cluster, designation, podId, placement)
+				if err := a.bus.HandleCommand(ctx, cmd); err != nil {
+					return err
+				}
+				return a.show(ctx, deviceResource, id)
+			}
+		},
+	}
+}
+
+func moveDeviceCmd() command {
+	return command{
+		name:    "move-device",
+		summary: "move a device to another elevation or rack",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				id, rackId, templateId, placement string
+				elevation                         int
+			)
+			fs.StringVar(&id, "id", "", "the id of the device (required)")
+			fs.StringVar(&rackId, "rack", "", "the id of the rack to move the device to (default: the rack of the device)")
+			fs.IntVar(&elevation, "elevation", 0, "the highest RU the device occupies once moved (default: placed by the placement)")
+			fs.StringVar(&templateId, "template", "", "the template the device was created with, for devices created before it was recorded")
+			fs.StringVar(&placement, "placement", "", "how the device is placed when no elevation is given: "+placements+" (default: the placement of its template)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewMoveDeviceCommand(id, rackId, elevation, templateId, placement)); err != nil {
+					return err
+				}
+				return a.show(ctx, deviceResource, id)
+			}
+		},
+	}
+}
+
+func unrackDeviceCmd() command {
+	return command{
+		name:    "unrack-device",
+		summary: "remove a device from its rack, freeing its RUs",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var id, reason string
+			fs.StringVar(&id, "id", "", "the id of the device (required)")
+			fs.StringVar(&reason, "reason", "", "why the device is unracked")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewUnrackDeviceCommand(id, reason)); err != nil {
+					return err
+				}
+				return a.show(ctx, deviceResource, id)
+			}
+		},
+	}
+}
+
+func decommissionDeviceCmd() command {
+	return command{
+		name:    "decommission-device",
+		summary: "unrack a device if it is racked and retire it",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var id, reason string
+			fs.StringVar(&id, "id", "", "the id of the device (required)")
+			fs.StringVar(&reason, "reason", "", "why the device is decommissioned")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"id": id}); err != nil {
+					return err
+				}
+
+				if err := a.bus.HandleCommand(ctx, v1.NewDecommissionDeviceCommand(id, reason)); err != nil {
+					return err
+				}
+				return a.show(ctx, deviceResource, id)
+			}
+		},
+	}
+}
+
+const (
+	datacenterResource     = "datacenter"
+	rackResource           = "rack"
+	podResource            = "pod"
+	deviceResource         = "device"
+	deviceTemplateResource = "template"
+)
+
+func showCmd() command {
+	return command{
+		name:    "show",
+		summary: "show a datacenter, rack, pod, device or template",
+		setup: func(fs *flag.FlagSet) runFunc {
+			fs.Usage = func() {
+				fmt.Fprintf(fs.Output(), "usage: dcgen show <datacenter|rack|pod|device|template> <id> [flags]\n")
+				fs.PrintDefaults()
+			}
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("%w: expected a resource and an id", ErrUsage)
+				}
+				return a.show(ctx, args[0], args[1])
+			}
+		},
+	}
+}
+
+// show prints the current state of the resource with the passed id.
+func (a *app) show(ctx context.Context, resource, id string) error {
+	var (
+		aggregate events.Aggregate
+		view      func() tabular
+	)
+	switch resource {
+	case datacenterResource:
+		dc := datacenterAggregate.NewDatacenterAggregateWithId(id)
+		aggregate, view = dc, func() tabular { return newDatacenterView(dc) }
+	case rackResource:
+		rack := rackAggregate.NewRackAggregateWithId(id)
+		aggregate, view = rack, func() tabular { return newRackView(rack) }
+	case podResource:
+		pod := podAggregate.NewPodAggregateWithId(id)
+		aggregate, view = pod, func() tabular { return newPodView(pod) }
+	case deviceResource:
+		device := deviceAggregate.NewDeviceAggregateWithId(id)
+		aggregate, view = device, func() tabular { return newDeviceView(device) }
+	case deviceTemplateResource:
+		template := deviceTemplateAggregate.NewDeviceTemplateAggregateWithId(id)
+		aggregate, view = template, func() tabular { return newDeviceTemplateView(template) }
+	default:
+		return fmt.Errorf("%w {%s}", ErrUnknownResource, resource)
+	}
+
+	if err := a.store.Exists(ctx, aggregate.GetId()); err != nil {
+		return err
+	}
+	if err := a.store.Load(ctx, aggregate); err != nil {
+		return err
+	}
+	return a.print(view())
+}
+
+func powerReportCmd() command {
+	return command{
+		name:    "power-report",
+		summary: "report the load of the power feeds of a rack, and their load when one of them fails",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var id string
+			fs.StringVar(&id, "rack", "", "the id of the rack (required)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				if err := required(map[string]string{"rack": id}); err != nil {
+					return err
+				}
+
+				rack := rackAggregate.NewRackAggregateWithId(id)
+				if err := a.store.Exists(ctx, rack.GetId()); err != nil {
+					return err
+				}
+				if err := a.store.Load(ctx, rack); err != nil {
+					return err
+				}
+				return a.print(newPowerReportView(id, rack.Rack.PowerReport()))
+			}
+		},
+	}
+}
+
+// the number of events read from the log of all streams at a time.
+const eventsBatchSize = 500
+
+func eventsCmd() command {
+	return command{
+		name:    "events",
+		summary: "list the events of a stream, or of every stream",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				streamId, aggregateType string
+				after                   uint64
+				limit                   int
+			)
+			fs.StringVar(&streamId, "stream", "", "the id of the stream to list, e.g. rack-r1 (default: every stream)")
+			fs.StringVar(&aggregateType, "type", "", "only list the events of aggregates of this type, e.g. rack")
+			fs.Uint64Var(&after, "after", 0, "only list events after this position in the log of every stream")
+			fs.IntVar(&limit, "limit", 0, "the maximum number of events to list (default: no limit)")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				matches := func(event events.Event) bool {
+					return aggregateType == "" || string(event.GetAggregateType()) == aggregateType
+				}
+
+				view := make(eventsView, 0)
+				appendEvent := func(event events.Event) bool {
+					if matches(event) {
+						view = append(view, newEventView(event))
+					}
+					return limit <= 0 || len(view) < limit
+				}
+
+				if streamId != "" {
+					evts, err := a.store.LoadEvents(ctx, streamId)
+					if err != nil {
+						return err
+					}
+					for _, event := range evts {
+						if !appendEvent(event) {
+							break
+						}
+					}
+					return a.print(view)
+				}
+
+				from := events.NewLogPosition(after)
+				for {
+					evts, err := a.store.ReadAll(ctx, from, eventsBatchSize)
+					if err != nil {
+						return err
+					}
+					for _, event := range evts {
+						if !appendEvent(event) {
+							return a.print(view)
+						}
+						from = event.GetLogPosition()
+					}
+					if len(evts) < eventsBatchSize {
+						return a.print(view)
+					}
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package cli
+
+import "errors"
+
+var (
+	ErrUsage               = errors.New("invalid usage")
+	ErrUnknownCommand      = errors.New("unknown command")
+	ErrUnknownStore        = errors.New("unknown store backend")
+	ErrUnknownOutput       = errors.New("unknown output format")
+	ErrUnknownResource     = errors.New("unknown resource")
+	ErrInvalidKeyValuePair = errors.New("invalid key=value pair")
+	ErrInvalidPowerFeed    = errors.New("invalid power feed")
+
+	ErrInvalidSnapshotFrequency = errors.New("invalid snapshot frequency")
+	ErrInvalidCommandTimeout    = errors.New("invalid command timeout")
+)
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+const (
+	memoryStore = "memory"
+	fileStore   = "file"
+	esdbStore   = "esdb"
+
+	tableOutput = "table"
+	jsonOutput  = "json"
+	yamlOutput  = "yaml"
+
+	defaultStore             = fileStore
+	defaultDir               = ".dcgen"
+	defaultConnectionString  = "esdb://localhost:2113?tls=false"
+	defaultSnapshotFrequency = 100
+	defaultCommandTimeout    = 30 * time.Second
+)
+
+// options are the flags shared by every command.
+type options struct {
+	// the event store backend (file/memory/esdb).
+	store string
+	// the design directory of the file store, and of the state of the sagas.
+	dir string
+	// the connection string of the esdb store.
+	connectionString string
+	// the number of events between the snapshots of racks and datacenters, 0 disables snapshots.
+	snapshotFrequency int64
+	// how long a command may take to be handled, 0 doesn't bound it.
+	commandTimeout time.Duration
+	// the format of the command's output (table/json/yaml).
+	output string
+
+	log logger.Options
+}
+
+func (o *options) attachCmdFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.store, "store", defaultStore, "the event store backend: file, memory or esdb. the memory store is discarded when the command exits")
+	fs.StringVar(&o.dir, "dir", defaultDir, "the design directory of the file store, the state of the sagas is kept in it with the file and esdb stores")
+	fs.StringVar(&o.connectionString, "esdb", defaultConnectionString, "the connection string of the esdb store")
+	fs.Int64Var(&o.snapshotFrequency, "snapshot-frequency", defaultSnapshotFrequency, "the number of events between the snapshots racks and datacenters are loaded from, 0 disables snapshots")
+	fs.DurationVar(&o.commandTimeout, "command-timeout", defaultCommandTimeout, "how long a command may take to be handled, 0 doesn't bound it")
+	fs.StringVar(&o.output, "output", tableOutput, "the output format: table, json or yaml")
+	fs.StringVar(&o.output, "o", tableOutput, "shorthand for --output")
+	o.log.AttachCmdFlags(fs.StringVar, fs.BoolVar)
+}
+
+func (o *options) validate() error {
+	switch o.store {
+	case memoryStore, fileStore, esdbStore:
+	default:
+		return fmt.Errorf("%w {%s}", ErrUnknownStore, o.store)
+	}
+
+	switch o.output {
+	case tableOutput, jsonOutput, yamlOutput:
+	default:
+		return fmt.Errorf("%w {%s}", ErrUnknownOutput, o.output)
+	}
+
+	if o.snapshotFrequency < 0 {
+		return fmt.Errorf("%w {%d}", ErrInvalidSnapshotFrequency, o.snapshotFrequency)
+	}
+
+	if o.commandTimeout < 0 {
+		return fmt.Errorf("%w {%s}", ErrInvalidCommandTimeout, o.commandTimeout)
+	}
+	return nil
+}
+
+// listFlag is a flag that can be repeated, each value is appended to the list.
+type listFlag []string
+
+func (f *listFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *listFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// keyValueFlag is a flag of the form key=value that can be repeated.
+type keyValueFlag map[string]string
+
+func (f keyValueFlag) String() string {
+	pairs := make([]string, 0, len(f))
+	for k, v := range f {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (f keyValueFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("%w {%s}", ErrInvalidKeyValuePair, value)
+	}
+	f[key] = val
+	return nil
+}
+
+// powerFeedFlag is a flag of the form name:amperage:voltage[:phases], e.g. A:30:208 or B:32:400:3, that can be
+// repeated.
+type powerFeedFlag []datacenter.PowerFeed
+
+func (f *powerFeedFlag) String() string {
+	feeds := make([]string, 0, len(*f))
+	for _, feed := range *f {
+		feeds = append(feeds, fmt.Sprintf("%s:%d:%d:%d", feed.Name, feed.Amperage, feed.Voltage, feed.Phases))
+	}
+	return strings.Join(feeds, ",")
+}
+
+func (f *powerFeedFlag) Set(value string) error {
+	parts := strings.Split(value, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] == "" {
+		return fmt.Errorf("%w {%s}", ErrInvalidPowerFeed, value)
+	}
+
+	numbers := make([]int, len(parts)-1)
+	for i, part := range parts[1:] {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("%w {%s}", ErrInvalidPowerFeed, value)
+		}
+		numbers[i] = n
+	}
+
+	feed := datacenter.PowerFeed{Name: parts[0], Amperage: numbers[0], Voltage: numbers[1]}
+	if len(numbers) == 3 {
+		feed.Phases = numbers[2]
+	}
+	*f = append(*f, feed)
+	return nil
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// tabular is implemented by the views that can be printed as a table.
+type tabular interface {
+	header() []string
+	rows() [][]string
+}
+
+// print writes the view to stdout in the output format chosen by the user.
+func (a *app) print(view tabular) error {
+	switch a.opts.output {
+	case jsonOutput:
+		encoder := json.NewEncoder(a.stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(view)
+	case yamlOutput:
+		encoder := yaml.NewEncoder(a.stdout)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(view); err != nil {
+			return err
+		}
+		return encoder.Close()
+	}
+
+	tw := tabwriter.NewWriter(a.stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(view.header(), "\t"))
+	for _, row := range view.rows() {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/api"
+	"github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi"
+	grpcapiv1 "github.com/malijoe/DatacenterGenerator/pkg/api/grpcapi/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/api/httpapi"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultAddr = ":8080"
+	// the time given to in-flight requests to complete once the server is asked to stop.
+	shutdownTimeout = 10 * time.Second
+)
+
+func serveCmd() command {
+	return command{
+		name:    "serve",
+		summary: "serve the HTTP/JSON and gRPC APIs",
+		setup: func(fs *flag.FlagSet) runFunc {
+			var (
+				addr, grpcAddr string
+				pollInterval   time.Duration
+			)
+			fs.StringVar(&addr, "addr", defaultAddr, "the address the HTTP API listens on")
+			fs.StringVar(&grpcAddr, "grpc-addr", "", "the address the gRPC API listens on (default: the gRPC API is not served)")
+			fs.DurationVar(&pollInterval, "poll-interval", time.Second, "how often the projections and event streams poll the store for new events")
+
+			return func(ctx context.Context, a *app, args []string) error {
+				ctx, cancel := context.WithCancel(ctx)
+				defer cancel()
+
+				repos := memoryRepositories()
+				runner := projectors.NewRunner(a.log, a.store, projectors.NewMemoryCheckpointStore(), []projectors.Projector{
+					projectors.NewDatacenterProjector(repos.Datacenters),
+					projectors.NewRackProjector(repos.Racks),
+					projectors.NewPodProjector(repos.Pods),
+					projectors.NewDeviceProjector(repos.Devices, repos.Racks),
+					projectors.NewDeviceTemplateProjector(repos.DeviceTemplates),
+				}, projectors.WithPollInterval(pollInterval))
+
+				// the sagas keep their own checkpoint, so they resume where they left off rather than from the first event.
+				sagaRunner := a.sagaRunner(projectors.WithPollInterval(pollInterval))
+
+				errs := make(chan error, 4)
+				go func() {
+					errs <- runner.Run(ctx)
+				}()
+				go func() {
+					errs <- sagaRunner.Run(ctx)
+				}()
+
+				handler := httpapi.NewServer(a.log, a.bus, repos, a.store, httpapi.WithPollInterval(pollInterval), httpapi.WithCommandMetrics(a.metrics))
+				srv := &http.Server{Addr: addr, Handler: handler}
+				srv.RegisterOnShutdown(handler.CloseStreams)
+				go func() {
+					a.log.Infof("(dcgen) serving the HTTP API on {%s}", addr)
+					if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						errs <- err
+					}
+				}()
+
+				var grpcServer *grpc.Server
+				if grpcAddr != "" {
+					lis, err := net.Listen("tcp", grpcAddr)
+					if err != nil {
+						return err
+					}
+					grpcServer = grpc.NewServer()
+					grpcapiv1.RegisterDatacenterGeneratorServer(grpcServer, grpcapi.NewServer(a.log, a.bus, repos, a.store, grpcapi.WithPollInterval(pollInterval)))
+					go func() {
+						a.log.Infof("(dcgen) serving the gRPC API on {%s}", grpcAddr)
+						errs <- grpcServer.Serve(lis)
+					}()
+				}
+
+				var err error
+				select {
+				case <-ctx.Done():
+				case err = <-errs:
+				}
+
+				shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+				defer cancelShutdown()
+				if shutdownErr := srv.Shutdown(shutdownCtx); shutdownErr != nil && err == nil {
+					err = shutdownErr
+				}
+				if grpcServer != nil {
+					// event tails only end when their client cancels them, they're cut off by Stop.
+					stopped := make(chan struct{})
+					go func() {
+						grpcServer.GracefulStop()
+						close(stopped)
+					}()
+					select {
+					case <-stopped:
+					case <-shutdownCtx.Done():
+						grpcServer.Stop()
+					}
+				}
+				return err
+			}
+		},
+	}
+}
+
+// memoryRepositories returns in-memory read models, they are rebuilt from the store every time the server starts.
+func memoryRepositories() api.Repositories {
+	return api.Repositories{
+		Datacenters:     projections.NewMemoryDatacenterRepository(),
+		Racks:           projections.NewMemoryRackRepository(),
+		Pods:            projections.NewMemoryPodRepository(),
+		Devices:         projections.NewMemoryDeviceRepository(),
+		DeviceTemplates: projections.NewMemoryDeviceTemplateRepository(),
+	}
+}
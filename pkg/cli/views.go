@@ -0,0 +1,463 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// the views are the shapes in which resources are printed, they are decoupled from the aggregates so that the
+// output of the tool doesn't change with their internals.
+
+type datacenterView struct {
+	ID        string            `json:"id" yaml:"id"`
+	Site      string            `json:"site" yaml:"site"`
+	Building  string            `json:"building,omitempty" yaml:"building,omitempty"`
+	Room      string            `json:"room,omitempty" yaml:"room,omitempty"`
+	Providers map[string]string `json:"providers,omitempty" yaml:"providers,omitempty"`
+	Racks     []string          `json:"racks" yaml:"racks"`
+	Pods      []string          `json:"pods" yaml:"pods"`
+	Devices   int               `json:"devices" yaml:"devices"`
+}
+
+func newDatacenterView(a *datacenterAggregate.DatacenterAggregate) *datacenterView {
+	dc := a.Datacenter
+	view := &datacenterView{
+		ID:        datacenterAggregate.GetDatacenterAggregateId(a.GetId()),
+		Site:      dc.Site,
+		Building:  dc.Building,
+		Room:      dc.Room,
+		Providers: make(map[string]string),
+		Racks:     make([]string, 0, len(dc.Racks)),
+		Pods:      make([]string, 0, len(dc.Pods)),
+		Devices:   len(dc.Devices),
+	}
+	for provider, speed := range dc.Providers {
+		view.Providers[provider] = speed.String()
+	}
+	for _, rack := range dc.Racks {
+		view.Racks = append(view.Racks, rack.ID)
+	}
+	for _, pod := range dc.Pods {
+		view.Pods = append(view.Pods, pod.ID)
+	}
+	return view
+}
+
+func (v *datacenterView) header() []string {
+	return []string{"ID", "SITE", "BUILDING", "ROOM", "PROVIDERS", "RACKS", "PODS", "DEVICES"}
+}
+
+func (v *datacenterView) rows() [][]string {
+	providers := make([]string, 0, len(v.Providers))
+	for provider, speed := range v.Providers {
+		providers = append(providers, provider+"="+speed)
+	}
+	sort.Strings(providers)
+
+	return [][]string{{v.ID, v.Site, v.Building, v.Room, list(providers), list(v.Racks), list(v.Pods), strconv.Itoa(v.Devices)}}
+}
+
+type rackView struct {
+	ID         string           `json:"id" yaml:"id"`
+	Name       string           `json:"name" yaml:"name"`
+	Size       int              `json:"size" yaml:"size"`
+	Datacenter string           `json:"datacenter" yaml:"datacenter"`
+	Deleted    bool             `json:"deleted,omitempty" yaml:"deleted,omitempty"`
+	Devices    []rackDeviceView `json:"devices" yaml:"devices"`
+	Power      *rackPowerView   `json:"power,omitempty" yaml:"power,omitempty"`
+	Load       *rackLoadView    `json:"load,omitempty" yaml:"load,omitempty"`
+	// the reserved RU(s) of the rack.
+	Reservations []rackReservationView `json:"reservations,omitempty" yaml:"reservations,omitempty"`
+}
+
+type rackReservationView struct {
+	RUs        string   `json:"rus" yaml:"rus"`
+	Reason     string   `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Categories []string `json:"categories,omitempty" yaml:"categories,omitempty"`
+}
+
+type rackLoadView struct {
+	// the weight of the devices racked in the rack, in kilograms.
+	Weight                  float64 `json:"weight" yaml:"weight"`
+	StaticRating            string  `json:"staticRating,omitempty" yaml:"staticRating,omitempty"`
+	DynamicRating           string  `json:"dynamicRating,omitempty" yaml:"dynamicRating,omitempty"`
+	OverDynamicRating       bool    `json:"overDynamicRating,omitempty" yaml:"overDynamicRating,omitempty"`
+	HeavyDeviceWeight       string  `json:"heavyDeviceWeight,omitempty" yaml:"heavyDeviceWeight,omitempty"`
+	HeavyDeviceMaxElevation int     `json:"heavyDeviceMaxElevation,omitempty" yaml:"heavyDeviceMaxElevation,omitempty"`
+}
+
+type rackPowerView struct {
+	Feeds             []datacenter.PowerFeed `json:"feeds" yaml:"feeds"`
+	DeratingThreshold float64                `json:"deratingThreshold" yaml:"deratingThreshold"`
+	Policy            string                 `json:"policy" yaml:"policy"`
+}
+
+type rackDeviceView struct {
+	Elevation int    `json:"elevation" yaml:"elevation"`
+	Device    string `json:"device" yaml:"device"`
+	// the face a half depth device is mounted on and the side a half width device is racked in.
+	Face string `json:"face,omitempty" yaml:"face,omitempty"`
+	Side string `json:"side,omitempty" yaml:"side,omitempty"`
+}
+
+func newRackView(a *rackAggregate.RackAggregate) *rackView {
+	rack := a.Rack
+	view := &rackView{
+		ID:      rackAggregate.GetRackAggregateId(a.GetId()),
+		Name:    rack.Name,
+		Size:    rack.Size,
+		Deleted: a.IsDeleted(),
+		Devices: make([]rackDeviceView, 0),
+	}
+	if rack.Datacenter != nil {
+		view.Datacenter = rack.Datacenter.ID
+	}
+	if len(rack.Power.Feeds) > 0 {
+		view.Power = &rackPowerView{
+			Feeds:             rack.Power.Feeds,
+			DeratingThreshold: rack.Power.DeratingThreshold,
+			Policy:            string(rack.Power.Policy),
+		}
+	}
+
+	if load := rack.Load; load != (datacenter.RackLoad{}) {
+		view.Load = &rackLoadView{
+			Weight:                  rack.Weight(),
+			StaticRating:            load.StaticRating.String(),
+			DynamicRating:           load.DynamicRating.String(),
+			OverDynamicRating:       rack.OverDynamicRating(),
+			HeavyDeviceWeight:       load.HeavyDeviceWeight.String(),
+			HeavyDeviceMaxElevation: load.HeavyDeviceMaxElevation,
+		}
+	}
+
+	for _, reservation := range rack.Reservations {
+		view.Reservations = append(view.Reservations, rackReservationView{
+			RUs:        reservation.RUs.String(),
+			Reason:     reservation.Reason,
+			Categories: reservation.Categories,
+		})
+	}
+
+	for _, device := range rack.RackedDevices() {
+		deviceView := rackDeviceView{Elevation: device.Elevation, Device: device.ID, Side: string(device.Side)}
+		if device.Model.Mounting.IsHalfDepth() {
+			deviceView.Face = string(device.Model.Mounting.MountedFace())
+		}
+		view.Devices = append(view.Devices, deviceView)
+	}
+	// devices are listed top down, the devices that share an RU from front to rear and left to right.
+	sort.SliceStable(view.Devices, func(i, j int) bool { return view.Devices[i].Elevation > view.Devices[j].Elevation })
+	return view
+}
+
+func (v *rackView) header() []string {
+	return []string{"ID", "NAME", "SIZE", "DATACENTER", "DEVICES", "FEEDS", "LOAD", "RESERVED", "DELETED"}
+}
+
+func (v *rackView) rows() [][]string {
+	devices := make([]string, 0, len(v.Devices))
+	for _, device := range v.Devices {
+		// e.g. '40:sw1', or '12/rear-left:pdu1' for a device that only occupies part of the RU.
+		position := strconv.Itoa(device.Elevation)
+		if place := strings.Trim(device.Face+"-"+device.Side, "-"); place != "" {
+			position += "/" + place
+		}
+		devices = append(devices, position+":"+device.Device)
+	}
+	feeds := make([]string, 0)
+	if v.Power != nil {
+		for _, feed := range v.Power.Feeds {
+			feeds = append(feeds, fmt.Sprintf("%s:%dA@%dV/%dph", feed.Name, feed.Amperage, feed.Voltage, feed.Phases))
+		}
+	}
+	reserved := make([]string, 0, len(v.Reservations))
+	for _, reservation := range v.Reservations {
+		// e.g. '42-45', or '1-2(pdu)' for RU(s) devices of the category may be racked in.
+		rus := reservation.RUs
+		if len(reservation.Categories) > 0 {
+			rus += "(" + strings.Join(reservation.Categories, "|") + ")"
+		}
+		reserved = append(reserved, rus)
+	}
+	// a range may list RU(s) separated by commas, the reservations are separated by semicolons.
+	reservations := "-"
+	if len(reserved) > 0 {
+		reservations = strings.Join(reserved, ";")
+	}
+	load := "-"
+	if v.Load != nil {
+		load = kilograms(v.Load.Weight)
+		if v.Load.StaticRating != "" {
+			load += "/" + v.Load.StaticRating
+		}
+		if v.Load.OverDynamicRating {
+			load += " (over dynamic rating)"
+		}
+	}
+	return [][]string{{v.ID, v.Name, strconv.Itoa(v.Size), v.Datacenter, list(devices), list(feeds), load, reservations, strconv.FormatBool(v.Deleted)}}
+}
+
+type podView struct {
+	ID         string `json:"id" yaml:"id"`
+	Name       string `json:"name" yaml:"name"`
+	Function   string `json:"function" yaml:"function"`
+	Instance   int    `json:"instance" yaml:"instance"`
+	Datacenter string `json:"datacenter" yaml:"datacenter"`
+	Deleted    bool   `json:"deleted,omitempty" yaml:"deleted,omitempty"`
+}
+
+func newPodView(a *podAggregate.PodAggregate) *podView {
+	pod := a.Pod
+	view := &podView{
+		ID:       podAggregate.GetPodAggregateId(a.GetId()),
+		Name:     pod.Name,
+		Function: string(pod.Function),
+		Instance: pod.Instance,
+		Deleted:  a.IsDeleted(),
+	}
+	if pod.Datacenter != nil {
+		view.Datacenter = pod.Datacenter.ID
+	}
+	return view
+}
+
+func (v *podView) header() []string {
+	return []string{"ID", "NAME", "FUNCTION", "INSTANCE", "DATACENTER", "DELETED"}
+}
+
+func (v *podView) rows() [][]string {
+	return [][]string{{v.ID, v.Name, v.Function, strconv.Itoa(v.Instance), v.Datacenter, strconv.FormatBool(v.Deleted)}}
+}
+
+type deviceView struct {
+	ID          string   `json:"id" yaml:"id"`
+	Hostname    string   `json:"hostname" yaml:"hostname"`
+	Model       string   `json:"model" yaml:"model"`
+	Rack        string   `json:"rack" yaml:"rack"`
+	Elevation   int      `json:"elevation" yaml:"elevation"`
+	Pod         string   `json:"pod,omitempty" yaml:"pod,omitempty"`
+	Cluster     int      `json:"cluster" yaml:"cluster"`
+	Designation string   `json:"designation" yaml:"designation"`
+	Instance    int      `json:"instance" yaml:"instance"`
+	Categories  []string `json:"categories" yaml:"categories"`
+	// racked, unracked or decommissioned.
+	Status string `json:"status" yaml:"status"`
+}
+
+func newDeviceView(a *deviceAggregate.DeviceAggregate) *deviceView {
+	device := a.Device
+	view := &deviceView{
+		ID:          deviceAggregate.GetDeviceAggregateId(a.GetId()),
+		Hostname:    device.Hostname,
+		Model:       device.Model.ID,
+		Elevation:   device.Elevation,
+		Cluster:     device.Cluster,
+		Designation: string(device.Designation),
+		Instance:    device.Instance,
+		Categories:  device.Categories,
+		Status:      "unracked",
+	}
+	if device.Rack != nil {
+		view.Rack = device.Rack.ID
+		view.Status = "racked"
+	}
+	if a.IsDecommissioned() {
+		view.Status = "decommissioned"
+	}
+	if device.Pod != nil {
+		view.Pod = device.Pod.ID
+	}
+	return view
+}
+
+func (v *deviceView) header() []string {
+	return []string{"ID", "HOSTNAME", "MODEL", "RACK", "ELEVATION", "POD", "CLUSTER", "DESIGNATION", "CATEGORIES", "STATUS"}
+}
+
+func (v *deviceView) rows() [][]string {
+	return [][]string{{v.ID, v.Hostname, v.Model, v.Rack, strconv.Itoa(v.Elevation), v.Pod, strconv.Itoa(v.Cluster), v.Designation, list(v.Categories), v.Status}}
+}
+
+type deviceTemplateView struct {
+	ID               string   `json:"id" yaml:"id"`
+	Model            string   `json:"model" yaml:"model"`
+	FormFactor       int      `json:"formFactor" yaml:"formFactor"`
+	Face             string   `json:"face,omitempty" yaml:"face,omitempty"`
+	Depth            string   `json:"depth,omitempty" yaml:"depth,omitempty"`
+	Width            string   `json:"width,omitempty" yaml:"width,omitempty"`
+	Weight           string   `json:"weight,omitempty" yaml:"weight,omitempty"`
+	TypicalPower     int      `json:"typicalPower,omitempty" yaml:"typicalPower,omitempty"`
+	MaxPower         int      `json:"maxPower,omitempty" yaml:"maxPower,omitempty"`
+	PSUs             int      `json:"psus,omitempty" yaml:"psus,omitempty"`
+	Variant          string   `json:"variant" yaml:"variant"`
+	Categories       []string `json:"categories" yaml:"categories"`
+	HostnameTemplate string   `json:"hostnameTemplate,omitempty" yaml:"hostnameTemplate,omitempty"`
+	Alias            string   `json:"alias,omitempty" yaml:"alias,omitempty"`
+	Function         string   `json:"function" yaml:"function"`
+	Placement        string   `json:"placement,omitempty" yaml:"placement,omitempty"`
+}
+
+func newDeviceTemplateView(a *deviceTemplateAggregate.DeviceTemplateAggregate) *deviceTemplateView {
+	template := a.DeviceTemplate
+	return &deviceTemplateView{
+		ID:               deviceTemplateAggregate.GetDeviceTemplateAggregateId(a.GetId()),
+		Model:            template.Model.ID,
+		FormFactor:       template.Model.FormFactor,
+		Face:             string(template.Model.Mounting.Face),
+		Depth:            string(template.Model.Mounting.Depth),
+		Width:            string(template.Model.Mounting.Width),
+		Weight:           template.Model.Weight.String(),
+		TypicalPower:     template.Model.Power.Typical,
+		MaxPower:         template.Model.Power.Max,
+		PSUs:             template.Model.Power.PSUs,
+		Variant:          template.Variant,
+		Categories:       template.Categories,
+		HostnameTemplate: template.HostnameTemplate,
+		Alias:            template.Alias,
+		Function:         string(template.Function),
+		Placement:        string(template.Placement),
+	}
+}
+
+func (v *deviceTemplateView) header() []string {
+	return []string{"ID", "MODEL", "FORM FACTOR", "MOUNTING", "WEIGHT", "POWER", "VARIANT", "CATEGORIES", "HOSTNAME TEMPLATE", "ALIAS", "FUNCTION", "PLACEMENT"}
+}
+
+func (v *deviceTemplateView) rows() [][]string {
+	power := hardware.PowerDraw{Typical: v.TypicalPower, Max: v.MaxPower, PSUs: v.PSUs}
+	weight := v.Weight
+	if weight == "" {
+		weight = "-"
+	}
+	return [][]string{{v.ID, v.Model, strconv.Itoa(v.FormFactor), mounting(v.Face, v.Depth, v.Width), weight, powerDraw(power), v.Variant, list(v.Categories), v.HostnameTemplate, v.Alias, v.Function, v.Placement}}
+}
+
+// mounting formats how a model is mounted as its face and the classes that aren't full, e.g. 'rear half-depth'.
+func mounting(face, depth, width string) string {
+	m := hardware.Mounting{Face: hardware.Face(face), Depth: hardware.Depth(depth), Width: hardware.Width(width)}
+	parts := []string{string(m.MountedFace())}
+	if m.IsHalfDepth() {
+		parts = append(parts, "half-depth")
+	}
+	if m.IsHalfWidth() {
+		parts = append(parts, "half-width")
+	}
+	return strings.Join(parts, " ")
+}
+
+// powerDraw formats the power drawn by a model as its typical and most draw and its number of power supplies, e.g.
+// '350W/500W x2'.
+func powerDraw(power hardware.PowerDraw) string {
+	if power.Peak() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%dW/%dW x%d", power.Typical, power.Peak(), power.Supplies())
+}
+
+// powerReportView lists the load of every feed of a rack, while every feed is up and then with each feed down.
+type powerReportView struct {
+	Rack                   string `json:"rack" yaml:"rack"`
+	datacenter.PowerReport `yaml:",inline"`
+}
+
+func newPowerReportView(rackId string, report datacenter.PowerReport) *powerReportView {
+	return &powerReportView{Rack: rackId, PowerReport: report}
+}
+
+func (v *powerReportView) header() []string {
+	return []string{"FAILED FEED", "FEED", "TYPICAL", "PEAK", "BUDGET", "CAPACITY", "STATUS", "UNPOWERED"}
+}
+
+func (v *powerReportView) rows() [][]string {
+	rows := make([][]string, 0)
+	appendLoads := func(failed string, loads []datacenter.FeedLoad, unpowered []string) {
+		for _, load := range loads {
+			status := "ok"
+			switch {
+			case load.OverCapacity:
+				status = "over capacity"
+			case load.OverBudget:
+				status = "over budget"
+			}
+			rows = append(rows, []string{failed, load.Feed, watts(load.Typical), watts(load.Peak), watts(load.Budget), watts(load.Capacity), status, list(unpowered)})
+		}
+	}
+
+	appendLoads("-", v.Feeds, nil)
+	for _, failure := range v.Failures {
+		appendLoads(failure.Failed, failure.Feeds, failure.Unpowered)
+	}
+	return rows
+}
+
+func watts(w float64) string {
+	return strconv.FormatFloat(w, 'f', 0, 64) + "W"
+}
+
+func kilograms(kg float64) string {
+	return strconv.FormatFloat(kg, 'f', 1, 64) + "kg"
+}
+
+type eventView struct {
+	Position  uint64    `json:"position" yaml:"position"`
+	Stream    string    `json:"stream" yaml:"stream"`
+	Version   int64     `json:"version" yaml:"version"`
+	Type      string    `json:"type" yaml:"type"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	// the event's data and metadata are decoded so that they are printed as documents rather than strings.
+	Data     any `json:"data,omitempty" yaml:"data,omitempty"`
+	Metadata any `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+type eventsView []eventView
+
+func newEventView(event events.Event) eventView {
+	view := eventView{
+		Position:  event.GetPosition(),
+		Stream:    event.GetAggregateId(),
+		Version:   event.GetVersion(),
+		Type:      string(event.GetEventType()),
+		Timestamp: event.GetTimestamp().UTC(),
+	}
+	if len(event.GetData()) > 0 {
+		_ = json.Unmarshal(event.GetData(), &view.Data)
+	}
+	if len(event.GetMetadata()) > 0 {
+		_ = json.Unmarshal(event.GetMetadata(), &view.Metadata)
+	}
+	return view
+}
+
+func (v eventsView) header() []string {
+	return []string{"POSITION", "STREAM", "VERSION", "TYPE", "TIMESTAMP"}
+}
+
+func (v eventsView) rows() [][]string {
+	rows := make([][]string, 0, len(v))
+	for _, event := range v {
+		rows = append(rows, []string{strconv.FormatUint(event.Position, 10), event.Stream, strconv.FormatInt(event.Version, 10), event.Type, event.Timestamp.Format(time.RFC3339)})
+	}
+	return rows
+}
+
+// list joins the values of a table cell, '-' is printed for empty lists.
+func list(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
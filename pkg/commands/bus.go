@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// HandlerFunc handles a command.
+type HandlerFunc func(ctx context.Context, cmd events.Command) error
+
+// Middleware wraps the handling of every command dispatched by a Bus.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Handler is implemented by the handlers of commands of type C, such as the handlers in pkg/commands/v1.
+type Handler[C events.Command] interface {
+	Handle(ctx context.Context, cmd C) error
+}
+
+// Bus routes commands to the handler registered for their type, through a pipeline of middleware.
+// Bus implements events.HandleCommand. concurrency conflicts are retried by the handlers, see v1.WithMaxRetries.
+type Bus struct {
+	mu         sync.RWMutex
+	handlers   map[reflect.Type]HandlerFunc
+	middleware []Middleware
+}
+
+// NewBus creates a bus that passes every command through the middleware in order, the first middleware being the
+// outermost.
+func NewBus(middleware ...Middleware) *Bus {
+	return &Bus{
+		handlers:   make(map[reflect.Type]HandlerFunc),
+		middleware: middleware,
+	}
+}
+
+// Register registers the handler of commands of type C. only one handler can be registered per command type.
+func Register[C events.Command](bus *Bus, handler Handler[C]) error {
+	commandType := reflect.TypeOf((*C)(nil)).Elem()
+
+	return bus.register(commandType, func(ctx context.Context, cmd events.Command) error {
+		c, ok := cmd.(C)
+		if !ok {
+			return fmt.Errorf("%w {%T}", events.ErrInvalidCommandType, cmd)
+		}
+		return handler.Handle(ctx, c)
+	})
+}
+
+func (b *Bus) register(commandType reflect.Type, handler HandlerFunc) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.handlers[commandType]; ok {
+		return fmt.Errorf("%w {%s}", ErrHandlerAlreadyRegistered, commandType)
+	}
+
+	// wrap in reverse so the first middleware is the first to see the command.
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		handler = b.middleware[i](handler)
+	}
+	b.handlers[commandType] = handler
+	return nil
+}
+
+// Dispatch handles the command with the handler registered for its type.
+func (b *Bus) Dispatch(ctx context.Context, cmd events.Command) error {
+	if cmd == nil {
+		return fmt.Errorf("%w: nil command", events.ErrInvalidCommand)
+	}
+
+	b.mu.RLock()
+	handler, ok := b.handlers[reflect.TypeOf(cmd)]
+	b.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: no handler registered for {%T}", events.ErrInvalidCommandType, cmd)
+	}
+
+	return handler(ctx, cmd)
+}
+
+func (b *Bus) HandleCommand(ctx context.Context, cmd events.Command) error {
+	return b.Dispatch(ctx, cmd)
+}
+
+// NewCommand returns a zero command of the registered type named name (see events.CommandName), so that commands
+// can be decoded by transports that only know the command's name. false is returned if no such type is registered.
+func (b *Bus) NewCommand(name string) (events.Command, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for commandType := range b.handlers {
+		if commandType.Kind() != reflect.Pointer {
+			continue
+		}
+		cmd, ok := reflect.New(commandType.Elem()).Interface().(events.Command)
+		if ok && events.CommandName(cmd) == name {
+			return cmd, true
+		}
+	}
+	return nil, false
+}
+
+// CommandNames returns the names of the registered command types, sorted.
+func (b *Bus) CommandNames() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	names := make([]string, 0, len(b.handlers))
+	for commandType := range b.handlers {
+		if commandType.Kind() == reflect.Pointer {
+			commandType = commandType.Elem()
+		}
+		names = append(names, commandType.Name())
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ProcessedCommand records a command that was handled successfully.
+type ProcessedCommand struct {
+	// identifies the command, see dedupKey.
+	Key string `json:"key"`
+	// the name of the command's type.
+	Command     string    `json:"command"`
+	ProcessedAt time.Time `json:"processedAt"`
+}
+
+// DedupStore remembers processed commands for a limited time.
+type DedupStore interface {
+	// Get returns the record of the command with the passed key. ErrCommandNotProcessed is returned if the command
+	// hasn't been processed or its record has expired.
+	Get(ctx context.Context, key string) (*ProcessedCommand, error)
+	// Put records the processed command for ttl.
+	Put(ctx context.Context, record *ProcessedCommand, ttl time.Duration) error
+}
+
+type dedupEntry struct {
+	record    ProcessedCommand
+	expiresAt time.Time
+}
+
+// memoryDedupStore is an in-process implementation of DedupStore. expired records are evicted as new records are
+// put, at most once per sweep interval.
+type memoryDedupStore struct {
+	mu        sync.Mutex
+	entries   map[string]dedupEntry
+	lastSweep time.Time
+	// the minimum time between sweeps of expired records.
+	sweepInterval time.Duration
+}
+
+func NewMemoryDedupStore() *memoryDedupStore {
+	return &memoryDedupStore{
+		entries:       make(map[string]dedupEntry),
+		lastSweep:     time.Now(),
+		sweepInterval: time.Minute,
+	}
+}
+
+func (s *memoryDedupStore) Get(ctx context.Context, key string) (*ProcessedCommand, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(s.entries, key)
+		return nil, fmt.Errorf("%w {%s}", ErrCommandNotProcessed, key)
+	}
+
+	record := entry.record
+	return &record, nil
+}
+
+func (s *memoryDedupStore) Put(ctx context.Context, record *ProcessedCommand, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.entries[record.Key] = dedupEntry{record: *record, expiresAt: now.Add(ttl)}
+
+	if now.Sub(s.lastSweep) >= s.sweepInterval {
+		for key, entry := range s.entries {
+			if now.After(entry.expiresAt) {
+				delete(s.entries, key)
+			}
+		}
+		s.lastSweep = now
+	}
+	return nil
+}
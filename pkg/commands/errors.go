@@ -0,0 +1,9 @@
+package commands
+
+import "errors"
+
+var (
+	ErrHandlerAlreadyRegistered = errors.New("handler already registered")
+	ErrCommandNotProcessed      = errors.New("command not processed")
+	ErrCommandIdConflict        = errors.New("command id conflict")
+)
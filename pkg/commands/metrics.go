@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"sync"
+	"time"
+)
+
+// MetricsRecorder receives the outcome of every command dispatched through the Metrics middleware.
+type MetricsRecorder interface {
+	ObserveCommand(command string, duration time.Duration, err error)
+}
+
+// CommandMetrics are the metrics recorded for a command type.
+type CommandMetrics struct {
+	Handled       int
+	Failed        int
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+}
+
+// memoryMetrics is an in-process MetricsRecorder that keeps counters per command type.
+type memoryMetrics struct {
+	mu       sync.RWMutex
+	commands map[string]CommandMetrics
+}
+
+func NewMemoryMetrics() *memoryMetrics {
+	return &memoryMetrics{commands: make(map[string]CommandMetrics)}
+}
+
+func (m *memoryMetrics) ObserveCommand(command string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	metrics := m.commands[command]
+	metrics.Handled++
+	if err != nil {
+		metrics.Failed++
+	}
+	metrics.TotalDuration += duration
+	if duration > metrics.MaxDuration {
+		metrics.MaxDuration = duration
+	}
+	m.commands[command] = metrics
+}
+
+// Snapshot returns a copy of the metrics of every command type, keyed by command name.
+func (m *memoryMetrics) Snapshot() map[string]CommandMetrics {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	snapshot := make(map[string]CommandMetrics, len(m.commands))
+	for command, metrics := range m.commands {
+		snapshot[command] = metrics
+	}
+	return snapshot
+}
@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// Validation rejects commands without an aggregate id, and commands implementing events.Validator that fail to
+// validate, before they reach their handler.
+func Validation() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			if cmd.GetAggregateId() == "" {
+				return fmt.Errorf("%w {%s}: aggregateId not provided", events.ErrInvalidCommand, events.CommandName(cmd))
+			}
+
+			if v, ok := cmd.(events.Validator); ok {
+				if err := v.Validate(); err != nil {
+					return fmt.Errorf("Validate {%s}: %w", events.CommandName(cmd), err)
+				}
+			}
+
+			return next(ctx, cmd)
+		}
+	}
+}
+
+// Logging logs every command, how long it took to handle and whether it failed.
+func Logging(log logger.Logger) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			start := time.Now()
+			err := next(ctx, cmd)
+
+			fields := map[string]any{
+				"command":     events.CommandName(cmd),
+				"aggregateId": cmd.GetAggregateId(),
+				"duration":    time.Since(start).String(),
+			}
+			if metadata := events.MetadataFromContext(ctx); metadata.CorrelationId != "" {
+				fields["correlationId"] = metadata.CorrelationId
+			}
+
+			if err != nil {
+				log.WithFields(fields).Warnf("command failed: %v", err)
+			} else {
+				log.WithFields(fields).Debug("command handled")
+			}
+			return err
+		}
+	}
+}
+
+// Metrics records the outcome and duration of every command.
+func Metrics(recorder MetricsRecorder) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			start := time.Now()
+			err := next(ctx, cmd)
+			recorder.ObserveCommand(events.CommandName(cmd), time.Since(start), err)
+			return err
+		}
+	}
+}
+
+// Deadline bounds the handling of every command by timeout. a shorter deadline already carried by the context is
+// kept.
+func Deadline(timeout time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("{%s}: %w", events.CommandName(cmd), err)
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
+
+// Idempotency turns a command that carries the id of a command already processed by the same aggregate into a no-op
+// that returns the original, successful, result. processed commands are remembered for ttl. commands that failed are
+// not remembered so they can be retried, and commands without a command id are always handled.
+func Idempotency(log logger.Logger, store DedupStore, ttl time.Duration) Middleware {
+	// serializes commands that share a key so a duplicate can't be handled while the original is in flight.
+	var locks keyedMutex
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			if cmd.GetCommandId() == "" {
+				return next(ctx, cmd)
+			}
+
+			key := dedupKey(cmd)
+			unlock := locks.lock(key)
+			defer unlock()
+
+			record, err := store.Get(ctx, key)
+			switch {
+			case err == nil:
+				if record.Command != events.CommandName(cmd) {
+					return fmt.Errorf("%w {%s}: processed as {%s}, received as {%s}", ErrCommandIdConflict, key, record.Command, events.CommandName(cmd))
+				}
+				log.Debugf("skipping {%s} with command id {%s}, processed at %s", record.Command, cmd.GetCommandId(), record.ProcessedAt)
+				return nil
+			case !errors.Is(err, ErrCommandNotProcessed):
+				return fmt.Errorf("DedupStore.Get: %w", err)
+			}
+
+			if err = next(ctx, cmd); err != nil {
+				return err
+			}
+
+			record = &ProcessedCommand{
+				Key:         key,
+				Command:     events.CommandName(cmd),
+				ProcessedAt: time.Now(),
+			}
+			if err = store.Put(ctx, record, ttl); err != nil {
+				// the command has been handled, failing to record it only means a replay isn't detected.
+				log.Warnf("failed to record command id {%s}: %v", key, err)
+			}
+			return nil
+		}
+	}
+}
+
+// dedupKey identifies a command by the aggregate it targets and its client-supplied id.
+func dedupKey(cmd events.Command) string {
+	return cmd.GetAggregateId() + "/" + cmd.GetCommandId()
+}
+
+// keyedMutex is a set of mutexes created on demand, one per key.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	// the number of callers holding or waiting for the lock.
+	refs int
+}
+
+// lock locks the mutex of the key and returns the function that unlocks it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = make(map[string]*keyedLock)
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		m.locks[key] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+
+		m.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, key)
+		}
+		m.mu.Unlock()
+	}
+}
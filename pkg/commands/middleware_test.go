@@ -0,0 +1,234 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+type testCommand struct {
+	events.BaseCommand
+	// the error returned by Validate.
+	invalid error
+}
+
+func newTestCommand(aggregateId, commandId string) *testCommand {
+	cmd := &testCommand{BaseCommand: events.NewBaseCommand(aggregateId)}
+	cmd.SetCommandId(commandId)
+	return cmd
+}
+
+func (c *testCommand) Validate() error {
+	return c.invalid
+}
+
+// otherTestCommand is a command of another type, sent with the id of a testCommand.
+type otherTestCommand struct {
+	events.BaseCommand
+}
+
+// testHandler counts the commands it handles and returns err.
+type testHandler struct {
+	handled int
+	err     error
+	ctx     context.Context
+}
+
+func (h *testHandler) handle(ctx context.Context, _ events.Command) error {
+	h.handled++
+	h.ctx = ctx
+	return h.err
+}
+
+// tracing returns a middleware that appends its name to calls when it sees a command, and again once it returns.
+func tracing(name string, calls *[]string) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd events.Command) error {
+			*calls = append(*calls, name)
+			err := next(ctx, cmd)
+			*calls = append(*calls, "/"+name)
+			return err
+		}
+	}
+}
+
+func TestBusMiddlewareOrder(t *testing.T) {
+	var calls []string
+	bus := NewBus(tracing("a", &calls), tracing("b", &calls), tracing("c", &calls))
+	if err := bus.register(reflect.TypeOf(&testCommand{}), func(context.Context, events.Command) error {
+		calls = append(calls, "handler")
+		return nil
+	}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+
+	if err := bus.Dispatch(context.Background(), newTestCommand("r1", "")); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if expected := "a b c handler /c /b /a"; strings.Join(calls, " ") != expected {
+		t.Fatalf("expected the calls %q, got %q", expected, strings.Join(calls, " "))
+	}
+}
+
+func TestValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      *testCommand
+		expected error
+	}{
+		{name: "valid", cmd: newTestCommand("r1", "")},
+		{name: "without an aggregate id", cmd: newTestCommand("", ""), expected: events.ErrInvalidCommand},
+		{name: "invalid", cmd: &testCommand{BaseCommand: events.NewBaseCommand("r1"), invalid: events.ErrInvalidCommand}, expected: events.ErrInvalidCommand},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := &testHandler{}
+			err := Validation()(handler.handle)(context.Background(), test.cmd)
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+			if handled := test.expected == nil; handled != (handler.handled == 1) {
+				t.Fatalf("expected the command to be handled %v, got %d calls", handled, handler.handled)
+			}
+		})
+	}
+}
+
+func TestLogging(t *testing.T) {
+	var buf bytes.Buffer
+	log := logger.NewLogger("middleware-test")
+	log.SetOutput(&buf)
+	log.SetOutputLevel(logger.DebugLevel)
+
+	ctx := events.ContextWithMetadata(context.Background(), events.EventMetadata{CorrelationId: "c1"})
+	handler := &testHandler{}
+	if err := Logging(log)(handler.handle)(ctx, newTestCommand("r1", "")); err != nil {
+		t.Fatalf("Logging: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "command handled") || !strings.Contains(out, "testCommand") || !strings.Contains(out, "c1") {
+		t.Fatalf("expected the handled command to be logged with its correlation id, got %q", out)
+	}
+
+	buf.Reset()
+	handler.err = errors.New("rack full")
+	if err := Logging(log)(handler.handle)(ctx, newTestCommand("r1", "")); !errors.Is(err, handler.err) {
+		t.Fatalf("expected %v, got %v", handler.err, err)
+	}
+	if out := buf.String(); !strings.Contains(out, "level=warning") || !strings.Contains(out, "command failed: rack full") {
+		t.Fatalf("expected the failure to be logged as a warning, got %q", out)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	handler := &testHandler{}
+	handle := Metrics(metrics)(handler.handle)
+
+	for _, err := range []error{nil, errors.New("rack full"), nil} {
+		handler.err = err
+		if got := handle(context.Background(), newTestCommand("r1", "")); !errors.Is(got, err) {
+			t.Fatalf("expected %v, got %v", err, got)
+		}
+	}
+	snapshot := metrics.Snapshot()
+	if m := snapshot["testCommand"]; m.Handled != 3 || m.Failed != 1 || m.MaxDuration > m.TotalDuration {
+		t.Fatalf("expected 3 commands handled and 1 failed, got %+v", m)
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	handler := &testHandler{}
+	started := time.Now()
+	if err := Deadline(time.Minute)(handler.handle)(context.Background(), newTestCommand("r1", "")); err != nil {
+		t.Fatalf("Deadline: %v", err)
+	}
+	deadline, ok := handler.ctx.Deadline()
+	if !ok || deadline.Before(started.Add(time.Minute)) {
+		t.Fatalf("expected the handler to be bound by a deadline in a minute, got %s", deadline)
+	}
+	if handler.ctx.Err() == nil {
+		t.Fatalf("expected the context of the handler to be cancelled once it returned")
+	}
+
+	// a shorter deadline of the caller is kept.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := Deadline(time.Minute)(handler.handle)(ctx, newTestCommand("r1", "")); err != nil {
+		t.Fatalf("Deadline: %v", err)
+	}
+	if deadline, _ = handler.ctx.Deadline(); !deadline.Before(started.Add(time.Minute)) {
+		t.Fatalf("expected the deadline of the caller, got %s", deadline)
+	}
+
+	// a command whose deadline has passed isn't handled.
+	handler.handled = 0
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	if err := Deadline(time.Minute)(handler.handle)(ctx, newTestCommand("r1", "")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if handler.handled != 0 {
+		t.Fatalf("expected the command not to be handled, got %d calls", handler.handled)
+	}
+}
+
+func TestIdempotency(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("middleware-test")
+	store := NewMemoryDedupStore()
+	handler := &testHandler{}
+	handle := Idempotency(log, store, time.Hour)(handler.handle)
+
+	// a replay returns the result of the original command without handling it again.
+	for attempt := 1; attempt <= 2; attempt++ {
+		if err := handle(ctx, newTestCommand("r1", "c1")); err != nil {
+			t.Fatalf("attempt %d: %v", attempt, err)
+		}
+	}
+	if handler.handled != 1 {
+		t.Fatalf("expected the command to be handled once, got %d calls", handler.handled)
+	}
+
+	// the same id sent to another aggregate is another command.
+	if err := handle(ctx, newTestCommand("r2", "c1")); err != nil || handler.handled != 2 {
+		t.Fatalf("expected the command to r2 to be handled, got %d calls, %v", handler.handled, err)
+	}
+
+	// the id of a command reused by another type of command is a conflict.
+	other := &otherTestCommand{BaseCommand: events.NewBaseCommand("r1")}
+	other.SetCommandId("c1")
+	if err := handle(ctx, other); !errors.Is(err, ErrCommandIdConflict) {
+		t.Fatalf("expected %v, got %v", ErrCommandIdConflict, err)
+	}
+
+	// a failed command isn't recorded and can be retried.
+	handler.handled, handler.err = 0, errors.New("rack full")
+	if err := handle(ctx, newTestCommand("r1", "c2")); !errors.Is(err, handler.err) {
+		t.Fatalf("expected %v, got %v", handler.err, err)
+	}
+	if _, err := store.Get(ctx, "r1/c2"); !errors.Is(err, ErrCommandNotProcessed) {
+		t.Fatalf("expected %v, got %v", ErrCommandNotProcessed, err)
+	}
+	handler.err = nil
+	if err := handle(ctx, newTestCommand("r1", "c2")); err != nil || handler.handled != 2 {
+		t.Fatalf("expected the retry to be handled, got %d calls, %v", handler.handled, err)
+	}
+
+	// commands without a command id are always handled.
+	handler.handled = 0
+	for i := 0; i < 2; i++ {
+		if err := handle(ctx, newTestCommand("r1", "")); err != nil {
+			t.Fatalf("command %d: %v", i, err)
+		}
+	}
+	if handler.handled != 2 {
+		t.Fatalf("expected both commands to be handled, got %d calls", handler.handled)
+	}
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// RegisterHandlers registers the handler of every v1 command with the bus.
+func RegisterHandlers(bus *commands.Bus, store events.AggregateStore, log logger.Logger, opts ...HandlerOption) error {
+	registrations := []func() error{
+		func() error {
+			return commands.Register[*InitDatacenterCommand](bus, NewInitDatacenterHandler(store, log))
+		},
+		func() error {
+			return commands.Register[*CreateRackCommand](bus, NewCreateRackCmdHandler(store, log))
+		},
+		func() error {
+			return commands.Register[*DeleteRackCommand](bus, NewDeleteRackCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*ConfigureRackPowerCommand](bus, NewConfigureRackPowerCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*ConfigureRackLoadCommand](bus, NewConfigureRackLoadCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*ReserveRUsCommand](bus, NewReserveRUsCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*ReleaseRUsCommand](bus, NewReleaseRUsCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*DatacenterAddRackCommand](bus, NewDatacenterAddRackCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*CreatePodCommand](bus, NewCreatePodCmdHandler(store, log))
+		},
+		func() error {
+			return commands.Register[*DeletePodCommand](bus, NewDeletePodCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*DatacenterAddPodCommand](bus, NewDatacenterAddPodCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*CreateDeviceCommand](bus, NewCreateDeviceCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*UnrackDeviceCommand](bus, NewUnrackDeviceCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*MoveDeviceCommand](bus, NewMoveDeviceCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*DecommissionDeviceCommand](bus, NewDecommissionDeviceCmdHandler(store, log, opts...))
+		},
+		func() error {
+			return commands.Register[*CreateDeviceTemplateCommand](bus, NewCreateDeviceTemplateCmdHandler(store, log))
+		},
+	}
+
+	for _, register := range registrations {
+		if err := register(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
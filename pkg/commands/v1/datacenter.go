@@ -3,13 +3,15 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
 
-	"github.com/EventStore/EventStore-Client-Go/esdb"
 	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
 	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
 	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
 	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
 	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
 )
@@ -26,6 +28,13 @@ func NewInitDatacenterCommand(aggregateId string, site string, building string,
 	return &InitDatacenterCommand{BaseCommand: events.NewBaseCommand(aggregateId), Site: site, Building: building, Room: room, Providers: providers}
 }
 
+func (c *InitDatacenterCommand) Validate() error {
+	if c.Site == "" {
+		return events.NewInvalidCommandError(datacenterAggregate.ErrSiteNotSpecified)
+	}
+	return nil
+}
+
 type InitDatacenterCmdHandler interface {
 	Handle(ctx context.Context, cmd *InitDatacenterCommand) error
 }
@@ -40,9 +49,11 @@ func NewInitDatacenterHandler(store events.AggregateStore, log logger.Logger) *i
 }
 
 func (h *initDatacenterCmdHandler) Handle(ctx context.Context, cmd *InitDatacenterCommand) error {
+	ctx = commandContext(ctx, cmd)
+
 	dc := datacenterAggregate.NewDatacenterAggregateWithId(cmd.GetAggregateId())
 	err := h.store.Exists(ctx, dc.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	if err != nil && !errors.Is(err, events.ErrAggregateNotFound) {
 		return err
 	}
 
@@ -50,7 +61,7 @@ func (h *initDatacenterCmdHandler) Handle(ctx context.Context, cmd *InitDatacent
 		return err
 	}
 
-	return h.store.Save(ctx, dc)
+	return saveAggregate(ctx, h.store, dc)
 }
 
 type CreateRackCommand struct {
@@ -64,6 +75,16 @@ func NewCreateRackCommand(aggregateId string, name string, size int, datacenterI
 	return &CreateRackCommand{BaseCommand: events.NewBaseCommand(aggregateId), Name: name, Size: size, DatacenterId: datacenterId}
 }
 
+func (c *CreateRackCommand) Validate() error {
+	if c.Name == "" {
+		return events.NewInvalidCommandError(rackAggregate.ErrRackNameNotSpecified)
+	}
+	if c.DatacenterId == "" {
+		return events.NewInvalidCommandError(rackAggregate.ErrDatacenterIDNotProvided)
+	}
+	return nil
+}
+
 type CreateRackCmdHandler interface {
 	Handle(ctx context.Context, cmd *CreateRackCommand) error
 }
@@ -78,10 +99,12 @@ func NewCreateRackCmdHandler(store events.AggregateStore, log logger.Logger) *cr
 }
 
 func (h *createRackCmdHandler) Handle(ctx context.Context, cmd *CreateRackCommand) error {
+	ctx = commandContext(ctx, cmd)
+
 	rack := rackAggregate.NewRackAggregateWithId(cmd.GetAggregateId())
 
 	err := h.store.Exists(ctx, rack.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	if err != nil && !errors.Is(err, events.ErrAggregateNotFound) {
 		return err
 	}
 
@@ -89,7 +112,267 @@ func (h *createRackCmdHandler) Handle(ctx context.Context, cmd *CreateRackComman
 		return err
 	}
 
-	return h.store.Save(ctx, rack)
+	return saveAggregate(ctx, h.store, rack)
+}
+
+type DeleteRackCommand struct {
+	events.BaseCommand
+	Reason string
+}
+
+func NewDeleteRackCommand(aggregateId string, reason string) *DeleteRackCommand {
+	return &DeleteRackCommand{BaseCommand: events.NewBaseCommand(aggregateId), Reason: reason}
+}
+
+type DeleteRackCmdHandler interface {
+	Handle(ctx context.Context, cmd *DeleteRackCommand) error
+}
+
+type deleteRackCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewDeleteRackCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *deleteRackCmdHandler {
+	return &deleteRackCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *deleteRackCmdHandler) Handle(ctx context.Context, cmd *DeleteRackCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = rack.DeleteRack(ctx, cmd.Reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, rack)
+	})
+}
+
+type ConfigureRackPowerCommand struct {
+	events.BaseCommand
+	Feeds             []datacenter.PowerFeed
+	DeratingThreshold float64
+	Policy            string
+}
+
+func NewConfigureRackPowerCommand(aggregateId string, feeds []datacenter.PowerFeed, deratingThreshold float64, policy string) *ConfigureRackPowerCommand {
+	return &ConfigureRackPowerCommand{BaseCommand: events.NewBaseCommand(aggregateId), Feeds: feeds, DeratingThreshold: deratingThreshold, Policy: policy}
+}
+
+func (c *ConfigureRackPowerCommand) Validate() error {
+	if len(c.Feeds) == 0 {
+		return events.NewInvalidCommandError(rackAggregate.ErrPowerFeedNotProvided)
+	}
+	return nil
+}
+
+type ConfigureRackPowerCmdHandler interface {
+	Handle(ctx context.Context, cmd *ConfigureRackPowerCommand) error
+}
+
+type configureRackPowerCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewConfigureRackPowerCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *configureRackPowerCmdHandler {
+	return &configureRackPowerCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *configureRackPowerCmdHandler) Handle(ctx context.Context, cmd *ConfigureRackPowerCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	rack := rackAggregate.NewRackAggregateWithId(cmd.GetAggregateId())
+	if err := h.store.Exists(ctx, rack.GetId()); err != nil {
+		return err
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		// the aggregate normalizes the feeds, a retry starts from the feeds of the command.
+		feeds := append([]datacenter.PowerFeed(nil), cmd.Feeds...)
+		if err = rack.ConfigurePower(ctx, feeds, cmd.DeratingThreshold, cmd.Policy); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, rack)
+	})
+}
+
+type ConfigureRackLoadCommand struct {
+	events.BaseCommand
+	StaticRating            string
+	DynamicRating           string
+	HeavyDeviceWeight       string
+	HeavyDeviceMaxElevation int
+}
+
+func NewConfigureRackLoadCommand(aggregateId string, staticRating, dynamicRating, heavyDeviceWeight string, heavyDeviceMaxElevation int) *ConfigureRackLoadCommand {
+	return &ConfigureRackLoadCommand{BaseCommand: events.NewBaseCommand(aggregateId), StaticRating: staticRating, DynamicRating: dynamicRating, HeavyDeviceWeight: heavyDeviceWeight, HeavyDeviceMaxElevation: heavyDeviceMaxElevation}
+}
+
+func (c *ConfigureRackLoadCommand) Validate() error {
+	if c.StaticRating == "" && c.DynamicRating == "" && c.HeavyDeviceWeight == "" {
+		return events.NewInvalidCommandError(rackAggregate.ErrLoadRatingNotProvided)
+	}
+	return nil
+}
+
+type ConfigureRackLoadCmdHandler interface {
+	Handle(ctx context.Context, cmd *ConfigureRackLoadCommand) error
+}
+
+type configureRackLoadCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewConfigureRackLoadCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *configureRackLoadCmdHandler {
+	return &configureRackLoadCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *configureRackLoadCmdHandler) Handle(ctx context.Context, cmd *ConfigureRackLoadCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	rack := rackAggregate.NewRackAggregateWithId(cmd.GetAggregateId())
+	if err := h.store.Exists(ctx, rack.GetId()); err != nil {
+		return err
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = rack.ConfigureLoad(ctx, cmd.StaticRating, cmd.DynamicRating, cmd.HeavyDeviceWeight, cmd.HeavyDeviceMaxElevation); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, rack)
+	})
+}
+
+type ReserveRUsCommand struct {
+	events.BaseCommand
+	// the reserved RU(s), e.g. '42-45'.
+	RUs        string
+	Reason     string
+	Categories []string
+}
+
+func NewReserveRUsCommand(aggregateId string, rus string, reason string, categories []string) *ReserveRUsCommand {
+	return &ReserveRUsCommand{BaseCommand: events.NewBaseCommand(aggregateId), RUs: rus, Reason: reason, Categories: categories}
+}
+
+func (c *ReserveRUsCommand) Validate() error {
+	if c.RUs == "" {
+		return events.NewInvalidCommandError(rackAggregate.ErrReservedRUsNotProvided)
+	}
+	return nil
+}
+
+type ReserveRUsCmdHandler interface {
+	Handle(ctx context.Context, cmd *ReserveRUsCommand) error
+}
+
+type reserveRUsCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewReserveRUsCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *reserveRUsCmdHandler {
+	return &reserveRUsCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *reserveRUsCmdHandler) Handle(ctx context.Context, cmd *ReserveRUsCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	rack := rackAggregate.NewRackAggregateWithId(cmd.GetAggregateId())
+	if err := h.store.Exists(ctx, rack.GetId()); err != nil {
+		return err
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = rack.ReserveRUs(ctx, cmd.RUs, cmd.Reason, cmd.Categories); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, rack)
+	})
+}
+
+type ReleaseRUsCommand struct {
+	events.BaseCommand
+	// the RU(s) the reservation was made with.
+	RUs    string
+	Reason string
+}
+
+func NewReleaseRUsCommand(aggregateId string, rus string, reason string) *ReleaseRUsCommand {
+	return &ReleaseRUsCommand{BaseCommand: events.NewBaseCommand(aggregateId), RUs: rus, Reason: reason}
+}
+
+func (c *ReleaseRUsCommand) Validate() error {
+	if c.RUs == "" {
+		return events.NewInvalidCommandError(rackAggregate.ErrReservedRUsNotProvided)
+	}
+	return nil
+}
+
+type ReleaseRUsCmdHandler interface {
+	Handle(ctx context.Context, cmd *ReleaseRUsCommand) error
+}
+
+type releaseRUsCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewReleaseRUsCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *releaseRUsCmdHandler {
+	return &releaseRUsCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *releaseRUsCmdHandler) Handle(ctx context.Context, cmd *ReleaseRUsCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	rack := rackAggregate.NewRackAggregateWithId(cmd.GetAggregateId())
+	if err := h.store.Exists(ctx, rack.GetId()); err != nil {
+		return err
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = rack.ReleaseRUs(ctx, cmd.RUs, cmd.Reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, rack)
+	})
 }
 
 type DatacenterAddRackCommand struct {
@@ -101,6 +384,13 @@ func NewDatacenterAddRackCommand(aggregateId string, rackId string) *DatacenterA
 	return &DatacenterAddRackCommand{BaseCommand: events.NewBaseCommand(aggregateId), RackId: rackId}
 }
 
+func (c *DatacenterAddRackCommand) Validate() error {
+	if c.RackId == "" {
+		return events.NewInvalidCommandError(datacenterAggregate.ErrRackIDNotProvided)
+	}
+	return nil
+}
+
 type DatacenterAddRackCmdHandler interface {
 	Handle(ctx context.Context, cmd *DatacenterAddRackCommand) error
 }
@@ -108,23 +398,37 @@ type DatacenterAddRackCmdHandler interface {
 type datacenterAddRackCmdHandler struct {
 	store events.AggregateStore
 	log   logger.Logger
+	opts  handlerOptions
 }
 
-func NewDatacenterAddRackCmdHandler(store events.AggregateStore, log logger.Logger) *datacenterAddRackCmdHandler {
-	return &datacenterAddRackCmdHandler{store: store, log: log}
+func NewDatacenterAddRackCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *datacenterAddRackCmdHandler {
+	return &datacenterAddRackCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
 }
 
+// Handle adds the rack to the datacenter. a deleted rack is rejected.
 func (h *datacenterAddRackCmdHandler) Handle(ctx context.Context, cmd *DatacenterAddRackCommand) error {
-	dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, cmd.GetAggregateId())
+	ctx = commandContext(ctx, cmd)
+
+	rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.RackId)
 	if err != nil {
 		return err
 	}
-
-	if err = dc.AddRack(ctx, cmd.RackId); err != nil {
-		return err
+	if rack.IsDeleted() {
+		return fmt.Errorf("%w {%s}", rackAggregate.ErrRackDeleted, cmd.RackId)
 	}
 
-	return h.store.Save(ctx, dc)
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = dc.AddRack(ctx, cmd.RackId); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, dc)
+	})
 }
 
 type CreatePodCommand struct {
@@ -137,6 +441,16 @@ func NewCreatePodCommand(aggregateId string, function string, datacenterId strin
 	return &CreatePodCommand{BaseCommand: events.NewBaseCommand(aggregateId), Function: function, DatacenterId: datacenterId}
 }
 
+func (c *CreatePodCommand) Validate() error {
+	if c.Function == "" {
+		return events.NewInvalidCommandError(podAggregate.ErrFunctionNotSpecified)
+	}
+	if c.DatacenterId == "" {
+		return fmt.Errorf("%w: datacenterId not provided", events.ErrInvalidCommand)
+	}
+	return nil
+}
+
 type CreatePodCmdHandler interface {
 	Handle(ctx context.Context, cmd *CreatePodCommand) error
 }
@@ -151,10 +465,12 @@ func NewCreatePodCmdHandler(store events.AggregateStore, log logger.Logger) *cre
 }
 
 func (h *createPodCmdHandler) Handle(ctx context.Context, cmd *CreatePodCommand) error {
+	ctx = commandContext(ctx, cmd)
+
 	pod := podAggregate.NewPodAggregateWithId(cmd.GetAggregateId())
 
 	err := h.store.Exists(ctx, pod.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	if err != nil && !errors.Is(err, events.ErrAggregateNotFound) {
 		return err
 	}
 
@@ -167,7 +483,47 @@ func (h *createPodCmdHandler) Handle(ctx context.Context, cmd *CreatePodCommand)
 		return err
 	}
 
-	return h.store.Save(ctx, pod)
+	return saveAggregate(ctx, h.store, pod)
+}
+
+type DeletePodCommand struct {
+	events.BaseCommand
+	Reason string
+}
+
+func NewDeletePodCommand(aggregateId string, reason string) *DeletePodCommand {
+	return &DeletePodCommand{BaseCommand: events.NewBaseCommand(aggregateId), Reason: reason}
+}
+
+type DeletePodCmdHandler interface {
+	Handle(ctx context.Context, cmd *DeletePodCommand) error
+}
+
+type deletePodCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewDeletePodCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *deletePodCmdHandler {
+	return &deletePodCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+func (h *deletePodCmdHandler) Handle(ctx context.Context, cmd *DeletePodCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		pod, err := podAggregate.LoadPodAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = pod.DeletePod(ctx, cmd.Reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, pod)
+	})
 }
 
 type DatacenterAddPodCommand struct {
@@ -179,6 +535,13 @@ func NewDatacenterAddPodCommand(aggregateId string, podId string) *DatacenterAdd
 	return &DatacenterAddPodCommand{BaseCommand: events.NewBaseCommand(aggregateId), PodId: podId}
 }
 
+func (c *DatacenterAddPodCommand) Validate() error {
+	if c.PodId == "" {
+		return events.NewInvalidCommandError(datacenterAggregate.ErrPodIDNotProvided)
+	}
+	return nil
+}
+
 type DatacenterAddPodCmdHandler interface {
 	Handle(ctx context.Context, cmd *DatacenterAddPodCommand) error
 }
@@ -186,23 +549,37 @@ type DatacenterAddPodCmdHandler interface {
 type datacenterAddPodCmdHandler struct {
 	store events.AggregateStore
 	log   logger.Logger
+	opts  handlerOptions
 }
 
-func NewDatacenterAddPodCmdHandler(store events.AggregateStore, log logger.Logger) *datacenterAddPodCmdHandler {
-	return &datacenterAddPodCmdHandler{store: store, log: log}
+func NewDatacenterAddPodCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *datacenterAddPodCmdHandler {
+	return &datacenterAddPodCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
 }
 
+// Handle adds the pod to the datacenter. a deleted pod is rejected.
 func (h *datacenterAddPodCmdHandler) Handle(ctx context.Context, cmd *DatacenterAddPodCommand) error {
-	dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, cmd.GetAggregateId())
+	ctx = commandContext(ctx, cmd)
+
+	pod, err := podAggregate.LoadPodAggregate(ctx, h.store, cmd.PodId)
 	if err != nil {
 		return err
 	}
-
-	if err = dc.AddPod(ctx, cmd.PodId); err != nil {
-		return err
+	if pod.IsDeleted() {
+		return fmt.Errorf("%w {%s}", podAggregate.ErrPodDeleted, cmd.PodId)
 	}
 
-	return h.store.Save(ctx, dc)
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = dc.AddPod(ctx, cmd.PodId, pod.Pod.Function); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, dc)
+	})
 }
 
 type CreateDeviceCommand struct {
@@ -213,10 +590,25 @@ type CreateDeviceCommand struct {
 	Cluster     int
 	Designation string
 	PodId       string
+	// how the device is placed when no elevation is given, the placement of the template if empty.
+	Placement string
 }
 
-func NewCreateDeviceCommand(aggregateId string, templateId string, elevation int, rackId string, cluster int, designation string, podId string) *CreateDeviceCommand {
-	return &CreateDeviceCommand{BaseCommand: events.NewBaseCommand(aggregateId), TemplateId: templateId, Elevation: elevation, RackId: rackId, Cluster: cluster, Designation: designation, PodId: podId}
+func NewCreateDeviceCommand(aggregateId string, templateId string, elevation int, rackId string, cluster int, designation string, podId string, placement string) *CreateDeviceCommand {
+	return &CreateDeviceCommand{BaseCommand: events.NewBaseCommand(aggregateId), TemplateId: templateId, Elevation: elevation, RackId: rackId, Cluster: cluster, Designation: designation, PodId: podId, Placement: placement}
+}
+
+func (c *CreateDeviceCommand) Validate() error {
+	if c.TemplateId == "" {
+		return fmt.Errorf("%w: templateId not provided", events.ErrInvalidCommand)
+	}
+	if c.RackId == "" {
+		return fmt.Errorf("%w: rackId not provided", events.ErrInvalidCommand)
+	}
+	if c.Placement != "" && datacenter.ParsePlacement(c.Placement) == datacenter.UnknownPlacement {
+		return events.NewInvalidCommandError(fmt.Errorf("%w {%s}", deviceAggregate.ErrInvalidPlacement, c.Placement))
+	}
+	return nil
 }
 
 type CreateDeviceCmdHandler interface {
@@ -226,31 +618,513 @@ type CreateDeviceCmdHandler interface {
 type createDeviceCmdHandler struct {
 	store events.AggregateStore
 	log   logger.Logger
+	opts  handlerOptions
 }
 
+func NewCreateDeviceCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *createDeviceCmdHandler {
+	return &createDeviceCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+// Handle creates the device, racks it and counts it towards the instances of its model in the datacenter. the rack is
+// saved first so that a device is never created in RU(s) that were claimed concurrently, then the datacenter is saved
+// under the version its instance was picked from so that concurrent devices are never named after the same instance.
+// a retry after a later step failed finds the RU(s) already claimed and the instance already picked.
 func (h *createDeviceCmdHandler) Handle(ctx context.Context, cmd *CreateDeviceCommand) error {
-	device := deviceAggregate.NewDeviceAggregateWithId(cmd.GetAggregateId())
+	ctx = commandContext(ctx, cmd)
 
+	device := deviceAggregate.NewDeviceAggregateWithId(cmd.GetAggregateId())
 	err := h.store.Exists(ctx, device.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	switch {
+	case err == nil:
+		return fmt.Errorf("%w {%s}", events.ErrAlreadyExists, device.GetId())
+	case !errors.Is(err, events.ErrAggregateNotFound):
+		return err
+	}
+
+	dt, err := deviceTemplateAggregate.LoadDeviceTemplateAggregate(ctx, h.store, cmd.TemplateId)
+	if err != nil {
+		return err
+	}
+	template := dt.DeviceTemplate
+
+	var pod *datacenter.Pod
+	if cmd.PodId != "" {
+		p, err := podAggregate.LoadPodAggregate(ctx, h.store, cmd.PodId)
+		if err != nil {
+			return err
+		}
+		if p.IsDeleted() {
+			return fmt.Errorf("%w {%s}", podAggregate.ErrPodDeleted, cmd.PodId)
+		}
+		pod = p.Pod
+	}
+
+	err = retryOnConflict(ctx, h.log, h.opts, func() error {
+		device = deviceAggregate.NewDeviceAggregateWithId(cmd.GetAggregateId())
+
+		rack, err := rackAggregate.LoadRackAggregate(ctx, h.store, cmd.RackId)
+		if err != nil {
+			return err
+		}
+
+		dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, rack.Rack.Datacenter.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = device.CreateDevice(ctx, template, dc.Datacenter, rack.Rack, pod, cmd.Elevation, cmd.Cluster, cmd.Designation, cmd.Placement); err != nil {
+			return err
+		}
+
+		// claimed by an earlier attempt to create the device.
+		if _, alreadyRacked := rack.Rack.GetDevice(device.Device.ID); !alreadyRacked {
+			warnOverBudget(h.log, rack.Rack, device.Device.ID, template.Model)
+			if err = rack.AddDevice(ctx, rackedDevice(device.Device, template), device.Device.Elevation); err != nil {
+				return err
+			}
+			warnOverDynamicRating(h.log, rack.Rack)
+
+			if err = saveAggregate(ctx, h.store, rack); err != nil {
+				return err
+			}
+		}
+
+		if dc.Datacenter.HasDevice(device.Device.ID) {
+			return nil
+		}
+		if err = dc.AddDevice(ctx, device.Device.ID, template.Model.ID, template.Variant, device.Device.Instance); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, dc)
+	})
+	if err != nil {
+		return err
+	}
+
+	return saveAggregate(ctx, h.store, device)
+}
+
+type UnrackDeviceCommand struct {
+	events.BaseCommand
+	Reason string
+}
+
+func NewUnrackDeviceCommand(aggregateId string, reason string) *UnrackDeviceCommand {
+	return &UnrackDeviceCommand{BaseCommand: events.NewBaseCommand(aggregateId), Reason: reason}
+}
+
+type UnrackDeviceCmdHandler interface {
+	Handle(ctx context.Context, cmd *UnrackDeviceCommand) error
+}
+
+type unrackDeviceCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewUnrackDeviceCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *unrackDeviceCmdHandler {
+	return &unrackDeviceCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+// Handle frees the RU(s) of the device in its rack. the device keeps its hostname and instance.
+func (h *unrackDeviceCmdHandler) Handle(ctx context.Context, cmd *UnrackDeviceCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	device, err := loadCreatedDevice(ctx, h.store, cmd.GetAggregateId())
+	if err != nil {
+		return err
+	}
+	if device.IsDecommissioned() {
+		return fmt.Errorf("%w {%s}", deviceAggregate.ErrDeviceDecommissioned, device.Device.ID)
+	}
+	if !device.IsRacked() {
+		return fmt.Errorf("%w {%s}", deviceAggregate.ErrDeviceNotRacked, device.Device.ID)
+	}
+
+	return unrackDevice(ctx, h.store, h.log, h.opts, cmd.GetAggregateId(), cmd.Reason)
+}
+
+type DecommissionDeviceCommand struct {
+	events.BaseCommand
+	Reason string
+}
+
+func NewDecommissionDeviceCommand(aggregateId string, reason string) *DecommissionDeviceCommand {
+	return &DecommissionDeviceCommand{BaseCommand: events.NewBaseCommand(aggregateId), Reason: reason}
+}
+
+type DecommissionDeviceCmdHandler interface {
+	Handle(ctx context.Context, cmd *DecommissionDeviceCommand) error
+}
+
+type decommissionDeviceCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewDecommissionDeviceCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *decommissionDeviceCmdHandler {
+	return &decommissionDeviceCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+// Handle unracks the device if it is still racked, then decommissions it.
+func (h *decommissionDeviceCmdHandler) Handle(ctx context.Context, cmd *DecommissionDeviceCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	device, err := loadCreatedDevice(ctx, h.store, cmd.GetAggregateId())
+	if err != nil {
+		return err
+	}
+	if device.IsDecommissioned() {
+		return fmt.Errorf("%w {%s}", deviceAggregate.ErrDeviceDecommissioned, device.Device.ID)
+	}
+	if device.IsRacked() {
+		if err = unrackDevice(ctx, h.store, h.log, h.opts, cmd.GetAggregateId(), cmd.Reason); err != nil {
+			return err
+		}
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = device.Decommission(ctx, cmd.Reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, device)
+	})
+}
+
+// unrackDevice frees the RU(s) of the device in its rack, then records on the device that it was unracked. like
+// createDeviceCmdHandler.Handle, the rack is saved before the device, so that a retry after the device failed to save
+// finds the RU(s) already freed and only records it on the device.
+func unrackDevice(ctx context.Context, store events.AggregateStore, log logger.Logger, opts handlerOptions, deviceId string, reason string) error {
+	err := retryOnConflict(ctx, log, opts, func() error {
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, deviceId)
+		if err != nil {
+			return err
+		}
+		if !device.IsRacked() {
+			// unracked concurrently, recording it on the device fails below.
+			return nil
+		}
+
+		rack, err := rackAggregate.LoadRackAggregate(ctx, store, device.Device.Rack.ID)
+		if err != nil {
+			return err
+		}
+		if _, ok := rack.Rack.GetDevice(device.Device.ID); !ok {
+			return nil
+		}
+
+		if err = rack.RemoveDevice(ctx, device.Device.ID, reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, store, rack)
+	})
+	if err != nil {
 		return err
 	}
 
+	return retryOnConflict(ctx, log, opts, func() error {
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, deviceId)
+		if err != nil {
+			return err
+		}
+
+		if err = device.RemoveFromRack(ctx, reason); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, store, device)
+	})
+}
+
+type MoveDeviceCommand struct {
+	events.BaseCommand
+	// the rack to move the device to, the rack of the device if empty.
+	RackId string
+	// the highest RU the device occupies once moved, the highest free range of RU(s) of the rack if 0.
+	Elevation int
+	// the template the device was created with, only needed for devices created before it was recorded.
+	TemplateId string
+	// how the device is placed when no elevation is given, the placement of its template if empty.
+	Placement string
+}
+
+func NewMoveDeviceCommand(aggregateId string, rackId string, elevation int, templateId string, placement string) *MoveDeviceCommand {
+	return &MoveDeviceCommand{BaseCommand: events.NewBaseCommand(aggregateId), RackId: rackId, Elevation: elevation, TemplateId: templateId, Placement: placement}
+}
+
+func (c *MoveDeviceCommand) Validate() error {
+	if c.RackId == "" && c.Elevation == 0 {
+		return fmt.Errorf("%w: rackId or elevation not provided", events.ErrInvalidCommand)
+	}
+	if c.Placement != "" && datacenter.ParsePlacement(c.Placement) == datacenter.UnknownPlacement {
+		return events.NewInvalidCommandError(fmt.Errorf("%w {%s}", deviceAggregate.ErrInvalidPlacement, c.Placement))
+	}
 	return nil
 }
 
+type MoveDeviceCmdHandler interface {
+	Handle(ctx context.Context, cmd *MoveDeviceCommand) error
+}
+
+type moveDeviceCmdHandler struct {
+	store events.AggregateStore
+	log   logger.Logger
+	opts  handlerOptions
+}
+
+func NewMoveDeviceCmdHandler(store events.AggregateStore, log logger.Logger, opts ...HandlerOption) *moveDeviceCmdHandler {
+	return &moveDeviceCmdHandler{store: store, log: log, opts: newHandlerOptions(opts...)}
+}
+
+// Handle moves the device to the elevation of the rack. like createDeviceCmdHandler.Handle, the RU(s) of the rack the
+// device is moved to are claimed before the device is saved, then the RU(s) it occupied in another rack are freed and
+// the move is recorded on the device. a retry after a later step failed finds the RU(s) already claimed.
+func (h *moveDeviceCmdHandler) Handle(ctx context.Context, cmd *MoveDeviceCommand) error {
+	ctx = commandContext(ctx, cmd)
+
+	device, err := loadCreatedDevice(ctx, h.store, cmd.GetAggregateId())
+	if err != nil {
+		return err
+	}
+	if device.IsDecommissioned() {
+		return fmt.Errorf("%w {%s}", deviceAggregate.ErrDeviceDecommissioned, device.Device.ID)
+	}
+
+	rackId := cmd.RackId
+	if rackId == "" {
+		if !device.IsRacked() {
+			return fmt.Errorf("%w {%s}", deviceAggregate.ErrDeviceNotRacked, device.Device.ID)
+		}
+		rackId = device.Device.Rack.ID
+	}
+	if err = h.store.Exists(ctx, rackAggregate.NewRackAggregateWithId(rackId).GetId()); err != nil {
+		return err
+	}
+	sameRack := device.IsRacked() && device.Device.Rack.ID == rackId
+
+	templateId := device.TemplateId()
+	if templateId == "" {
+		templateId = cmd.TemplateId
+	}
+	if templateId == "" {
+		return fmt.Errorf("%w: templateId of device {%s} not provided", deviceAggregate.ErrTemplateNotRecorded, device.Device.ID)
+	}
+	dt, err := deviceTemplateAggregate.LoadDeviceTemplateAggregate(ctx, h.store, templateId)
+	if err != nil {
+		return err
+	}
+	template := dt.DeviceTemplate
+	strategy := template.PlacementStrategy(datacenter.ParsePlacement(cmd.Placement))
+
+	var pod *datacenter.Pod
+	if device.Device.Pod != nil {
+		p, err := podAggregate.LoadPodAggregate(ctx, h.store, device.Device.Pod.ID)
+		if err != nil {
+			return err
+		}
+		pod = p.Pod
+	}
+
+	var (
+		rack      *rackAggregate.RackAggregate
+		dc        *datacenterAggregate.DatacenterAggregate
+		elevation int
+	)
+	err = retryOnConflict(ctx, h.log, h.opts, func() error {
+		var err error
+		rack, err = rackAggregate.LoadRackAggregate(ctx, h.store, rackId)
+		if err != nil {
+			return err
+		}
+		if rack.IsDeleted() {
+			return fmt.Errorf("%w {%s}", rackAggregate.ErrRackDeleted, rackId)
+		}
+
+		dc, err = datacenterAggregate.LoadDatacenterAggregate(ctx, h.store, rack.Rack.Datacenter.ID)
+		if err != nil {
+			return err
+		}
+
+		racked, alreadyRacked := rack.Rack.GetDevice(device.Device.ID)
+		switch {
+		case sameRack:
+			elevation, err = rack.MoveDevice(ctx, device.Device.ID, cmd.Elevation, strategy, "moved within the rack")
+		case alreadyRacked:
+			// claimed by an earlier attempt of the move.
+			elevation = racked.Elevation
+		default:
+			racking := rackedDevice(device.Device, template)
+			elevation, err = claimRUs(rack.Rack, racking, cmd.Elevation, strategy)
+			if err == nil {
+				warnOverBudget(h.log, rack.Rack, device.Device.ID, template.Model)
+				err = rack.AddDevice(ctx, racking, elevation)
+			}
+			if err == nil {
+				warnOverDynamicRating(h.log, rack.Rack)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		// check that the device can be moved before the rack is saved.
+		probe, err := deviceAggregate.LoadDeviceAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+		if err = probe.Move(ctx, template, dc.Datacenter, rack.Rack, pod, elevation); err != nil {
+			return err
+		}
+
+		if len(rack.GetUncommittedEvents()) == 0 {
+			return nil
+		}
+		return saveAggregate(ctx, h.store, rack)
+	})
+	if err != nil {
+		return err
+	}
+
+	if device.IsRacked() && !sameRack {
+		fromRackId := device.Device.Rack.ID
+		err = retryOnConflict(ctx, h.log, h.opts, func() error {
+			from, err := rackAggregate.LoadRackAggregate(ctx, h.store, fromRackId)
+			if err != nil {
+				return err
+			}
+			if _, ok := from.Rack.GetDevice(device.Device.ID); !ok {
+				return nil
+			}
+
+			if err = from.RemoveDevice(ctx, device.Device.ID, fmt.Sprintf("moved to rack {%s}", rackId)); err != nil {
+				return err
+			}
+
+			return saveAggregate(ctx, h.store, from)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return retryOnConflict(ctx, h.log, h.opts, func() error {
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, h.store, cmd.GetAggregateId())
+		if err != nil {
+			return err
+		}
+
+		if err = device.Move(ctx, template, dc.Datacenter, rack.Rack, pod, elevation); err != nil {
+			return err
+		}
+
+		return saveAggregate(ctx, h.store, device)
+	})
+}
+
+// claimRUs returns the elevation the device can be racked at in the rack: the passed elevation, or the elevation the
+// strategy places it at among the ranges of free RU(s) outside the RU(s) reserved for other categories it doesn't
+// overload the rack in if it is 0.
+func claimRUs(rack *datacenter.Rack, device *datacenter.Device, elevation int, strategy datacenter.PlacementStrategy) (int, error) {
+	model := device.Model
+	if elevation == 0 {
+		el, ok := rack.FindPlacement(device, strategy)
+		if !ok {
+			if err := rack.FitError(model, device.Categories, 0); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+				return 0, err
+			}
+			return 0, fmt.Errorf("%w {%s}, formFactor: {%d}", deviceAggregate.ErrCantFitDeviceInRack, rack.Name, model.FormFactor)
+		}
+		return el, nil
+	}
+	if !rack.CanFitDeviceAt(model, elevation) {
+		if err := rack.FitError(model, device.Categories, elevation); !errors.Is(err, datacenter.ErrUnableToFitDevice) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%w {%s}, elevation {%d}, formFactor: {%d}", deviceAggregate.ErrCantFitDeviceInRack, rack.Name, elevation, model.FormFactor)
+	}
+	return elevation, nil
+}
+
+// rackedDevice returns the device as it is racked, with the model and the categories of the template it was created
+// with.
+func rackedDevice(device *datacenter.Device, template *datacenter.DeviceTemplate) *datacenter.Device {
+	racked := *device
+	racked.Model = template.Model
+	racked.Categories = template.Categories
+	return &racked
+}
+
+// warnOverBudget logs a warning if racking the device pushes a feed of a rack that racks devices over its power budget
+// over it. a rack that rejects them fails to rack the device instead.
+func warnOverBudget(log logger.Logger, rack *datacenter.Rack, deviceId string, model hardware.HardwareModel) {
+	if rack.Power.Policy != datacenter.WarnOverBudget {
+		return
+	}
+	device := datacenter.NewDevice()
+	device.ID = deviceId
+	device.Model = model
+	if err := rack.CheckPower(device); err != nil {
+		log.Warnf("racking device {%s}: %v", deviceId, err)
+	}
+}
+
+// warnOverDynamicRating logs a warning if the devices racked in the rack weigh more than its dynamic rating, the rack
+// can't be rolled into place loaded.
+func warnOverDynamicRating(log logger.Logger, rack *datacenter.Rack) {
+	if rack.OverDynamicRating() {
+		log.Warnf("rack {%s}: the devices weigh %.1fkg, over its dynamic rating of %s, it can't be moved loaded", rack.Name, rack.Weight(), rack.Load.DynamicRating)
+	}
+}
+
+// loadCreatedDevice is like deviceAggregate.LoadDeviceAggregate but returns events.ErrAggregateNotFound if the device
+// hasn't been created.
+func loadCreatedDevice(ctx context.Context, store events.AggregateStore, deviceId string) (*deviceAggregate.DeviceAggregate, error) {
+	device := deviceAggregate.NewDeviceAggregateWithId(deviceId)
+	if err := store.Exists(ctx, device.GetId()); err != nil {
+		return nil, err
+	}
+	return deviceAggregate.LoadDeviceAggregate(ctx, store, deviceId)
+}
+
 type CreateDeviceTemplateCommand struct {
 	events.BaseCommand
 	ModelId          string
+	FormFactor       int
+	Face             string
+	Depth            string
+	Width            string
+	Weight           string
+	TypicalPower     int
+	MaxPower         int
+	PSUs             int
 	Variant          string
 	Categories       []string
 	HostnameTemplate string
 	Alias            string
 	Function         string
+	// how devices created using the template are placed when no elevation is given. (top-down if empty)
+	Placement string
+}
+
+func NewCreateDeviceTemplateCommand(aggregateId string, modelId string, formFactor int, mounting hardware.Mounting, weight string, power hardware.PowerDraw, variant string, categories []string, hostnameTemplate, alias, function, placement string) *CreateDeviceTemplateCommand {
+	return &CreateDeviceTemplateCommand{BaseCommand: events.NewBaseCommand(aggregateId), ModelId: modelId, FormFactor: formFactor, Face: string(mounting.Face), Depth: string(mounting.Depth), Width: string(mounting.Width), Weight: weight, TypicalPower: power.Typical, MaxPower: power.Max, PSUs: power.PSUs, Variant: variant, Categories: categories, HostnameTemplate: hostnameTemplate, Alias: alias, Function: function, Placement: placement}
 }
 
-func NewCreateDeviceTemplateCommand(aggregateId string, modelId, variant string, categories []string, hostnameTemplate, alias, function string) *CreateDeviceTemplateCommand {
-	return &CreateDeviceTemplateCommand{BaseCommand: events.NewBaseCommand(aggregateId), ModelId: modelId, Variant: variant, Categories: categories, Alias: alias, Function: function}
+func (c *CreateDeviceTemplateCommand) Validate() error {
+	if c.ModelId == "" {
+		return events.NewInvalidCommandError(deviceTemplateAggregate.ErrModelIdNotProvided)
+	}
+	return nil
 }
 
 type CreateDeviceTemplateCmdHandler interface {
@@ -267,12 +1141,18 @@ func NewCreateDeviceTemplateCmdHandler(store events.AggregateStore, log logger.L
 }
 
 func (h *createDeviceTemplateCmdHandler) Handle(ctx context.Context, cmd *CreateDeviceTemplateCommand) error {
+	ctx = commandContext(ctx, cmd)
+
 	deviceTemplate := deviceTemplateAggregate.NewDeviceTemplateAggregateWithId(cmd.GetAggregateId())
 
 	err := h.store.Exists(ctx, deviceTemplate.GetId())
-	if err != nil && !errors.Is(err, esdb.ErrStreamNotFound) {
+	if err != nil && !errors.Is(err, events.ErrAggregateNotFound) {
 		return err
 	}
 
-	return nil
+	if err = deviceTemplate.CreateDeviceTemplate(ctx, cmd.ModelId, cmd.FormFactor, hardware.Mounting{Face: hardware.Face(cmd.Face), Depth: hardware.Depth(cmd.Depth), Width: hardware.Width(cmd.Width)}, cmd.Weight, hardware.PowerDraw{Typical: cmd.TypicalPower, Max: cmd.MaxPower, PSUs: cmd.PSUs}, cmd.Variant, cmd.Categories, cmd.HostnameTemplate, cmd.Alias, cmd.Function, cmd.Placement); err != nil {
+		return err
+	}
+
+	return saveAggregate(ctx, h.store, deviceTemplate)
 }
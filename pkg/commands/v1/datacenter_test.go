@@ -0,0 +1,365 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+// failingStore fails to save the aggregates of its type until it is told to stop.
+type failingStore struct {
+	events.AggregateStore
+	mu       sync.Mutex
+	failType events.AggregateType
+}
+
+func (s *failingStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	s.mu.Lock()
+	fail := aggregate.GetType() == s.failType
+	s.mu.Unlock()
+	if fail {
+		return errUnavailable
+	}
+	return s.AggregateStore.Save(ctx, aggregate)
+}
+
+func (s *failingStore) failSaving(aggregateType events.AggregateType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failType = aggregateType
+}
+
+// newDatacenter saves a datacenter with a 42U rack and a 1U compute template to the store.
+func newDatacenter(t *testing.T, store events.AggregateStore) {
+	t.Helper()
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+
+	if err := NewInitDatacenterHandler(store, log).Handle(ctx, NewInitDatacenterCommand("dc1", "dal1", "", "", nil)); err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	if err := NewCreateRackCmdHandler(store, log).Handle(ctx, NewCreateRackCommand("r1", "a01", 42, "dc1")); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if err := NewDatacenterAddRackCmdHandler(store, log).Handle(ctx, NewDatacenterAddRackCommand("dc1", "r1")); err != nil {
+		t.Fatalf("DatacenterAddRack: %v", err)
+	}
+	cmd := NewCreateDeviceTemplateCommand("t1", "srv", 1, hardware.Mounting{}, "", hardware.PowerDraw{}, "", []string{"compute"}, "{{.Site}}-srv{{.Number}}", "", "", "")
+	if err := NewCreateDeviceTemplateCmdHandler(store, log).Handle(ctx, cmd); err != nil {
+		t.Fatalf("CreateDeviceTemplate: %v", err)
+	}
+}
+
+func TestCreateDeviceRetryAfterFailure(t *testing.T) {
+	ctx := context.Background()
+	store := &failingStore{AggregateStore: eventstore.NewMemoryStore()}
+	newDatacenter(t, store)
+	handler := NewCreateDeviceCmdHandler(store, logger.NewLogger("test"))
+
+	// the rack and the datacenter are saved, the device isn't.
+	store.failSaving(deviceAggregate.DeviceAggregateType)
+	if err := handler.Handle(ctx, NewCreateDeviceCommand("d1", "t1", 0, "r1", 0, "", "", "")); !errors.Is(err, errUnavailable) {
+		t.Fatalf("expected %v, got %v", errUnavailable, err)
+	}
+
+	store.failSaving("")
+	if err := handler.Handle(ctx, NewCreateDeviceCommand("d1", "t1", 0, "r1", 0, "", "", "")); err != nil {
+		t.Fatalf("retrying CreateDevice: %v", err)
+	}
+
+	device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, "d1")
+	if err != nil {
+		t.Fatalf("LoadDeviceAggregate: %v", err)
+	}
+	if device.Device.Instance != 1 || device.Device.Hostname != "DAL1-SRV01" || device.Device.Elevation != 42 {
+		t.Fatalf("expected DAL1-SRV01 at 42, got %s (instance %d) at %d", device.Device.Hostname, device.Device.Instance, device.Device.Elevation)
+	}
+
+	rack, err := rackAggregate.LoadRackAggregate(ctx, store, "r1")
+	if err != nil {
+		t.Fatalf("LoadRackAggregate: %v", err)
+	}
+	if racked := rack.Rack.RackedDevices(); len(racked) != 1 {
+		t.Fatalf("expected the device to be racked once, got %d devices", len(racked))
+	}
+	dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, store, "dc1")
+	if err != nil {
+		t.Fatalf("LoadDatacenterAggregate: %v", err)
+	}
+	if n := len(dc.Datacenter.Devices); n != 1 {
+		t.Fatalf("expected the device to be added once, got %d devices", n)
+	}
+}
+
+func TestCreateDeviceConcurrentInstances(t *testing.T) {
+	ctx := context.Background()
+	store := eventstore.NewMemoryStore()
+	newDatacenter(t, store)
+	handler := NewCreateDeviceCmdHandler(store, logger.NewLogger("test"), WithMaxRetries(10))
+
+	ids := []string{"d1", "d2", "d3", "d4"}
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			errs[i] = handler.Handle(ctx, NewCreateDeviceCommand(id, "t1", 0, "r1", 0, "", "", ""))
+		}(i, id)
+	}
+	wg.Wait()
+
+	hostnames := make(map[string]string)
+	for i, id := range ids {
+		if errs[i] != nil {
+			t.Fatalf("CreateDevice {%s}: %v", id, errs[i])
+		}
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, id)
+		if err != nil {
+			t.Fatalf("LoadDeviceAggregate: %v", err)
+		}
+		if other, ok := hostnames[device.Device.Hostname]; ok {
+			t.Fatalf("devices {%s} and {%s} are both named %s", other, id, device.Device.Hostname)
+		}
+		hostnames[device.Device.Hostname] = id
+	}
+}
+
+func TestCreateDeviceInReservationOfCategory(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+	newDatacenter(t, store)
+
+	// every RU of the rack is reserved for the category of the template, whatever its case.
+	if err := NewReserveRUsCmdHandler(store, log).Handle(ctx, NewReserveRUsCommand("r1", "1-42", "test", []string{"Compute"})); err != nil {
+		t.Fatalf("ReserveRUs: %v", err)
+	}
+	if err := NewCreateDeviceCmdHandler(store, log).Handle(ctx, NewCreateDeviceCommand("d1", "t1", 0, "r1", 0, "", "", "")); err != nil {
+		t.Fatalf("CreateDevice: %v", err)
+	}
+
+	rack, err := rackAggregate.LoadRackAggregate(ctx, store, "r1")
+	if err != nil {
+		t.Fatalf("LoadRackAggregate: %v", err)
+	}
+	if categories := rack.Rack.Reservations[0].Categories; len(categories) != 1 || categories[0] != "compute" {
+		t.Fatalf("expected the reservation to allow {compute}, got %v", categories)
+	}
+}
+
+func TestMoveDevice(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+	newDatacenter(t, store)
+
+	if err := NewCreateRackCmdHandler(store, log).Handle(ctx, NewCreateRackCommand("r2", "a02", 42, "dc1")); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if err := NewDatacenterAddRackCmdHandler(store, log).Handle(ctx, NewDatacenterAddRackCommand("dc1", "r2")); err != nil {
+		t.Fatalf("DatacenterAddRack: %v", err)
+	}
+	if err := NewReserveRUsCmdHandler(store, log).Handle(ctx, NewReserveRUsCommand("r2", "30", "patching", []string{"patch-panel"})); err != nil {
+		t.Fatalf("ReserveRUs: %v", err)
+	}
+	cmd := NewCreateDeviceTemplateCommand("t2", "sw", 2, hardware.Mounting{}, "", hardware.PowerDraw{}, "", []string{"network"}, "{{.Site}}-{{.Rack}}-u{{.Elevation}}", "", "", "")
+	if err := NewCreateDeviceTemplateCmdHandler(store, log).Handle(ctx, cmd); err != nil {
+		t.Fatalf("CreateDeviceTemplate: %v", err)
+	}
+	if err := NewCreateDeviceCmdHandler(store, log).Handle(ctx, NewCreateDeviceCommand("d1", "t2", 10, "r1", 0, "", "", "")); err != nil {
+		t.Fatalf("CreateDevice: %v", err)
+	}
+	handler := NewMoveDeviceCmdHandler(store, log)
+
+	// lastEventTypes returns the types of the last n events of the stream.
+	lastEventTypes := func(streamId string, n int) []events.EventType {
+		evts, err := store.LoadEvents(ctx, streamId)
+		if err != nil {
+			t.Fatalf("LoadEvents: %v", err)
+		}
+		types := make([]events.EventType, 0, n)
+		for _, event := range evts[len(evts)-n:] {
+			types = append(types, event.GetEventType())
+		}
+		return types
+	}
+	expectDevice := func(rackId string, elevation int, hostname string) {
+		t.Helper()
+		device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, "d1")
+		if err != nil {
+			t.Fatalf("LoadDeviceAggregate: %v", err)
+		}
+		if device.Device.Rack.ID != rackId || device.Device.Elevation != elevation || device.Device.Hostname != hostname {
+			t.Fatalf("expected %s at %s/%d, got %s at %s/%d", hostname, rackId, elevation, device.Device.Hostname, device.Device.Rack.ID, device.Device.Elevation)
+		}
+		rack, err := rackAggregate.LoadRackAggregate(ctx, store, rackId)
+		if err != nil {
+			t.Fatalf("LoadRackAggregate: %v", err)
+		}
+		if racked, ok := rack.Rack.GetDevice("d1"); !ok || racked.Elevation != elevation {
+			t.Fatalf("expected rack {%s} to hold the device at %d, got %v", rackId, elevation, racked)
+		}
+	}
+
+	// the device occupies 10 and 9 of a01, the RU(s) it is shifted to overlap them.
+	if err := handler.Handle(ctx, NewMoveDeviceCommand("d1", "", 11, "", "")); err != nil {
+		t.Fatalf("MoveDevice within the rack: %v", err)
+	}
+	expectDevice("r1", 11, "DAL1-A01-U11")
+	if types := lastEventTypes("rack-r1", 2); types[0] != eventsv1.DeviceUnracked || types[1] != eventsv1.DeviceRacked {
+		t.Fatalf("expected the device to be unracked and racked again in a01, got %v", types)
+	}
+
+	// the RU(s) reserved for patch panels are refused, nothing is saved.
+	if err := handler.Handle(ctx, NewMoveDeviceCommand("d1", "r2", 30, "", "")); !errors.Is(err, datacenter.ErrRUsReserved) {
+		t.Fatalf("expected %v, got %v", datacenter.ErrRUsReserved, err)
+	}
+	if types := lastEventTypes("rack-r2", 1); types[0] != eventsv1.RackRUsReserved {
+		t.Fatalf("expected a02 to be unchanged, got %v", types)
+	}
+
+	if err := handler.Handle(ctx, NewMoveDeviceCommand("d1", "r2", 20, "", "")); err != nil {
+		t.Fatalf("MoveDevice to another rack: %v", err)
+	}
+	expectDevice("r2", 20, "DAL1-A02-U20")
+	if types := lastEventTypes("rack-r1", 1); types[0] != eventsv1.DeviceUnracked {
+		t.Fatalf("expected the device to be unracked from a01, got %v", types)
+	}
+	if types := lastEventTypes("rack-r2", 1); types[0] != eventsv1.DeviceRacked {
+		t.Fatalf("expected the device to be racked in a02, got %v", types)
+	}
+	if types := lastEventTypes("device-d1", 1); types[0] != eventsv1.DeviceMoved {
+		t.Fatalf("expected the move to be recorded on the device, got %v", types)
+	}
+}
+
+func TestCreateDeviceOverPowerBudget(t *testing.T) {
+	tests := []struct {
+		policy   string
+		expected error
+		warned   bool
+	}{
+		{policy: "reject", expected: datacenter.ErrPowerBudgetExceeded},
+		{policy: "warn", warned: true},
+	}
+	for _, test := range tests {
+		t.Run(test.policy, func(t *testing.T) {
+			ctx := context.Background()
+			var output bytes.Buffer
+			log := logger.NewLogger("test-power-" + test.policy)
+			log.SetOutput(&output)
+			store := eventstore.NewMemoryStore()
+			newDatacenter(t, store)
+
+			// the A and B feeds of a01 may each draw 1800W, a server draws 1200W from each of them at its peak.
+			feeds := []datacenter.PowerFeed{
+				{Name: "A", Phases: 1, Amperage: 10, Voltage: 240},
+				{Name: "B", Phases: 1, Amperage: 10, Voltage: 240},
+			}
+			if err := NewConfigureRackPowerCmdHandler(store, log).Handle(ctx, NewConfigureRackPowerCommand("r1", feeds, 0.75, test.policy)); err != nil {
+				t.Fatalf("ConfigureRackPower: %v", err)
+			}
+			cmd := NewCreateDeviceTemplateCommand("t2", "gpu", 2, hardware.Mounting{}, "", hardware.PowerDraw{Typical: 1600, Max: 2400, PSUs: 2}, "", []string{"compute"}, "{{.Site}}-gpu{{.Number}}", "", "", "")
+			if err := NewCreateDeviceTemplateCmdHandler(store, log).Handle(ctx, cmd); err != nil {
+				t.Fatalf("CreateDeviceTemplate: %v", err)
+			}
+
+			handler := NewCreateDeviceCmdHandler(store, log)
+			if err := handler.Handle(ctx, NewCreateDeviceCommand("d1", "t2", 0, "r1", 0, "", "", "")); err != nil {
+				t.Fatalf("CreateDevice within the budget: %v", err)
+			}
+			if output.Len() != 0 {
+				t.Fatalf("expected no warning within the budget, got %s", output.String())
+			}
+
+			err := handler.Handle(ctx, NewCreateDeviceCommand("d2", "t2", 0, "r1", 0, "", "", ""))
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+			if warned := strings.Contains(output.String(), "feed {A} of rack {a01} would draw 2400W of its 1800W budget"); warned != test.warned {
+				t.Fatalf("expected a warning %v, got %q", test.warned, output.String())
+			}
+
+			rack, err := rackAggregate.LoadRackAggregate(ctx, store, "r1")
+			if err != nil {
+				t.Fatalf("LoadRackAggregate: %v", err)
+			}
+			if _, racked := rack.Rack.GetDevice("d2"); racked != test.warned {
+				t.Fatalf("expected the second device racked %v", test.warned)
+			}
+			if rack.Rack.PowerReport().OverBudget() != test.warned {
+				t.Fatalf("expected the rack over its budget %v", test.warned)
+			}
+		})
+	}
+}
+
+func TestCreateDeviceLoadRatings(t *testing.T) {
+	ctx := context.Background()
+	var output bytes.Buffer
+	log := logger.NewLogger("test-load")
+	log.SetOutput(&output)
+	store := eventstore.NewMemoryStore()
+	newDatacenter(t, store)
+
+	// a 100lb UPS is 45.4kg, a01 can be rolled with 50kg in it, and devices of 40kg and up are kept in its lower 10 RU(s).
+	cmd := NewCreateDeviceTemplateCommand("t2", "ups", 2, hardware.Mounting{}, "100lb", hardware.PowerDraw{}, "", []string{"power"}, "{{.Site}}-ups{{.Number}}", "", "", "")
+	if err := NewCreateDeviceTemplateCmdHandler(store, log).Handle(ctx, cmd); err != nil {
+		t.Fatalf("CreateDeviceTemplate: %v", err)
+	}
+	if err := NewConfigureRackLoadCmdHandler(store, log).Handle(ctx, NewConfigureRackLoadCommand("r1", "250lb", "50kg", "40kg", 10)); err != nil {
+		t.Fatalf("ConfigureRackLoad: %v", err)
+	}
+
+	handler := NewCreateDeviceCmdHandler(store, log)
+	if err := handler.Handle(ctx, NewCreateDeviceCommand("d1", "t2", 30, "r1", 0, "", "", "")); !errors.Is(err, datacenter.ErrHeavyDeviceTooHigh) {
+		t.Fatalf("expected %v, got %v", datacenter.ErrHeavyDeviceTooHigh, err)
+	}
+
+	// placed automatically at the highest elevation heavy devices are allowed at.
+	if err := handler.Handle(ctx, NewCreateDeviceCommand("d1", "t2", 0, "r1", 0, "", "", "")); err != nil {
+		t.Fatalf("CreateDevice: %v", err)
+	}
+	device, err := deviceAggregate.LoadDeviceAggregate(ctx, store, "d1")
+	if err != nil {
+		t.Fatalf("LoadDeviceAggregate: %v", err)
+	}
+	if device.Device.Elevation != 10 {
+		t.Fatalf("expected the UPS at 10, got %d", device.Device.Elevation)
+	}
+	if output.Len() != 0 {
+		t.Fatalf("expected no warning within the dynamic rating, got %s", output.String())
+	}
+
+	// over the dynamic rating the device is racked, with a warning, up to the static rating.
+	if err = handler.Handle(ctx, NewCreateDeviceCommand("d2", "t2", 0, "r1", 0, "", "", "")); err != nil {
+		t.Fatalf("CreateDevice: %v", err)
+	}
+	if !strings.Contains(output.String(), "the devices weigh 90.7kg, over its dynamic rating of 50Kg") {
+		t.Fatalf("expected a warning over the dynamic rating, got %q", output.String())
+	}
+	if err = handler.Handle(ctx, NewCreateDeviceCommand("d3", "t2", 0, "r1", 0, "", "", "")); !errors.Is(err, datacenter.ErrRackOverloaded) {
+		t.Fatalf("expected %v, got %v", datacenter.ErrRackOverloaded, err)
+	}
+
+	// the ratings are checked against the racked devices.
+	if err = NewConfigureRackLoadCmdHandler(store, log).Handle(ctx, NewConfigureRackLoadCommand("r1", "", "", "90lb", 8)); !errors.Is(err, datacenter.ErrHeavyDeviceTooHigh) {
+		t.Fatalf("expected %v, got %v", datacenter.ErrHeavyDeviceTooHigh, err)
+	}
+}
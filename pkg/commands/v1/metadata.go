@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"os"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	uuid "github.com/satori/go.uuid"
+)
+
+// commandContext returns a copy of ctx whose event metadata names the passed command as the producer of any events
+// saved using it. a correlation id is generated if ctx does not already carry one, and the source host defaults to
+// the host handling the command. the id of the command, if the client supplied one, is recorded as the cause of the
+// events.
+func commandContext(ctx context.Context, cmd events.Command) context.Context {
+	metadata := events.MetadataFromContext(ctx)
+	metadata.Command = events.CommandName(cmd)
+	if cmd.GetCommandId() != "" {
+		metadata.CausationId = cmd.GetCommandId()
+	}
+	if metadata.CorrelationId == "" {
+		metadata.CorrelationId = uuid.NewV4().String()
+	}
+	if metadata.SourceHost == "" {
+		metadata.SourceHost, _ = os.Hostname()
+	}
+	return events.ContextWithMetadata(ctx, metadata)
+}
+
+// saveAggregate stamps the uncommitted events of the aggregate with the event metadata carried by ctx and saves it.
+func saveAggregate(ctx context.Context, store events.AggregateStore, aggregate events.Aggregate) error {
+	if err := aggregate.SetMetadata(events.MetadataFromContext(ctx)); err != nil {
+		return err
+	}
+	return store.Save(ctx, aggregate)
+}
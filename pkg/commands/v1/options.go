@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultMinBackoff = 10 * time.Millisecond
+	defaultMaxBackoff = 200 * time.Millisecond
+)
+
+// HandlerOption configures a command handler.
+type HandlerOption func(*handlerOptions)
+
+type handlerOptions struct {
+	// the number of times a command is retried after its aggregate fails to save with events.ErrConcurrencyConflict.
+	maxRetries int
+	// the bounds of the exponential backoff between retries.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newHandlerOptions(opts ...HandlerOption) handlerOptions {
+	options := handlerOptions{
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+	}
+	for _, op := range opts {
+		op(&options)
+	}
+	return options
+}
+
+// WithMaxRetries sets the number of times a command is retried after a concurrency conflict. (default 3)
+// a value of 0 disables retries.
+func WithMaxRetries(n int) HandlerOption {
+	return func(o *handlerOptions) {
+		if n >= 0 {
+			o.maxRetries = n
+		}
+	}
+}
+
+// WithRetryBackoff sets the delay before the first retry and the maximum delay between retries. (default 10ms, 200ms)
+// the delay doubles after every conflict, and a random part of it is dropped so that the commands that conflicted
+// don't retry in lockstep.
+func WithRetryBackoff(min, max time.Duration) HandlerOption {
+	return func(o *handlerOptions) {
+		if min > 0 && max >= min {
+			o.minBackoff = min
+			o.maxBackoff = max
+		}
+	}
+}
+
+// retryOnConflict calls fn, which is expected to load, decide and save, and calls it again up to the maximum number of
+// retries for as long as it fails with events.ErrConcurrencyConflict, backing off between attempts. the handlers are
+// the only layer that retries conflicts, the command bus doesn't.
+func retryOnConflict(ctx context.Context, log logger.Logger, opts handlerOptions, fn func() error) error {
+	backoff := opts.minBackoff
+	err := fn()
+	for attempt := 1; attempt <= opts.maxRetries && errors.Is(err, events.ErrConcurrencyConflict); attempt++ {
+		delay := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		log.Debugf("retrying command after concurrency conflict in %s (attempt %d/%d): %v", delay, attempt, opts.maxRetries, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		err = fn()
+		backoff *= 2
+		if backoff > opts.maxBackoff {
+			backoff = opts.maxBackoff
+		}
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// conflictingStore counts the aggregates it loads and saves. before a save it lets a concurrent command save first,
+// once, and fails the saves it is told to with events.ErrConcurrencyConflict.
+type conflictingStore struct {
+	events.AggregateStore
+	mu         sync.Mutex
+	loads      int
+	saves      int
+	concurrent func()
+	conflicts  int
+}
+
+func (s *conflictingStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	s.mu.Lock()
+	s.loads++
+	s.mu.Unlock()
+	return s.AggregateStore.Load(ctx, aggregate)
+}
+
+func (s *conflictingStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	s.mu.Lock()
+	s.saves++
+	concurrent := s.concurrent
+	s.concurrent = nil
+	conflict := s.conflicts > 0
+	if conflict {
+		s.conflicts--
+	}
+	s.mu.Unlock()
+
+	if concurrent != nil {
+		concurrent()
+	}
+	if conflict {
+		return fmt.Errorf("%w: stream {%s}", events.ErrConcurrencyConflict, aggregate.GetId())
+	}
+	return s.AggregateStore.Save(ctx, aggregate)
+}
+
+// attempts returns the number of loads and saves since the last call.
+func (s *conflictingStore) attempts() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	loads, saves := s.loads, s.saves
+	s.loads, s.saves = 0, 0
+	return loads, saves
+}
+
+func TestRetryOnConflictReloads(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := &conflictingStore{AggregateStore: eventstore.NewMemoryStore()}
+	newDatacenter(t, store)
+	store.attempts()
+
+	// 41 is reserved by another command between the load and the save of the first attempt.
+	store.concurrent = func() {
+		if err := NewReserveRUsCmdHandler(store.AggregateStore, log).Handle(ctx, NewReserveRUsCommand("r1", "41", "patching", nil)); err != nil {
+			t.Errorf("concurrent ReserveRUs: %v", err)
+		}
+	}
+	handler := NewReserveRUsCmdHandler(store, log, WithRetryBackoff(time.Millisecond, time.Millisecond))
+	if err := handler.Handle(ctx, NewReserveRUsCommand("r1", "42", "patching", nil)); err != nil {
+		t.Fatalf("ReserveRUs: %v", err)
+	}
+	if loads, saves := store.attempts(); loads != 2 || saves != 2 {
+		t.Fatalf("expected the rack to be loaded and saved twice, got %d loads and %d saves", loads, saves)
+	}
+
+	rack, err := rackAggregate.LoadRackAggregate(ctx, store.AggregateStore, "r1")
+	if err != nil {
+		t.Fatalf("LoadRackAggregate: %v", err)
+	}
+	if len(rack.Rack.Reservations) != 2 {
+		t.Fatalf("expected both reservations, got %v", rack.Rack.Reservations)
+	}
+}
+
+func TestRetryOnConflictGivesUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []HandlerOption
+		attempts int
+	}{
+		{name: "default", attempts: defaultMaxRetries + 1},
+		{name: "max retries", opts: []HandlerOption{WithMaxRetries(5)}, attempts: 6},
+		{name: "no retries", opts: []HandlerOption{WithMaxRetries(0)}, attempts: 1},
+		{name: "negative retries", opts: []HandlerOption{WithMaxRetries(-1)}, attempts: defaultMaxRetries + 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := &conflictingStore{AggregateStore: eventstore.NewMemoryStore()}
+			newDatacenter(t, store)
+			store.attempts()
+			store.conflicts = 100
+
+			opts := append([]HandlerOption{WithRetryBackoff(time.Millisecond, time.Millisecond)}, test.opts...)
+			handler := NewReserveRUsCmdHandler(store, logger.NewLogger("test"), opts...)
+			if err := handler.Handle(ctx, NewReserveRUsCommand("r1", "42", "patching", nil)); !errors.Is(err, events.ErrConcurrencyConflict) {
+				t.Fatalf("expected %v, got %v", events.ErrConcurrencyConflict, err)
+			}
+			if loads, saves := store.attempts(); loads != test.attempts || saves != test.attempts {
+				t.Fatalf("expected %d attempts, got %d loads and %d saves", test.attempts, loads, saves)
+			}
+		})
+	}
+}
+
+func TestRetryOnConflictStopsWhenCancelled(t *testing.T) {
+	store := &conflictingStore{AggregateStore: eventstore.NewMemoryStore()}
+	newDatacenter(t, store)
+	store.attempts()
+	store.conflicts = 100
+
+	// the context is done long before the first retry.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	handler := NewReserveRUsCmdHandler(store, logger.NewLogger("test"), WithRetryBackoff(time.Minute, time.Minute))
+	started := time.Now()
+	if err := handler.Handle(ctx, NewReserveRUsCommand("r1", "42", "patching", nil)); !errors.Is(err, events.ErrConcurrencyConflict) {
+		t.Fatalf("expected %v, got %v", events.ErrConcurrencyConflict, err)
+	}
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Fatalf("expected the handler to give up once cancelled, took %s", elapsed)
+	}
+	if _, saves := store.attempts(); saves != 1 {
+		t.Fatalf("expected a single attempt, got %d", saves)
+	}
+}
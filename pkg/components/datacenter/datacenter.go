@@ -20,6 +20,8 @@ type Datacenter struct {
 	Racks []*Rack
 	// the pods that exist in the datacenter
 	Pods []*Pod
+	// the devices that exist in the datacenter.
+	Devices []*Device
 
 	// used to track the number of instances by function of pods in the datacenter.
 	podMetadata map[Function]int
@@ -52,5 +54,29 @@ func (d *Datacenter) CountPod(function Function) {
 
 // CountDevice iterates the datacenter's instance counter for devices of the passed modelPID and variant.
 func (d *Datacenter) CountDevice(modelPID string, variant string) {
+	if _, ok := d.deviceMetadata[modelPID]; !ok {
+		d.deviceMetadata[modelPID] = make(map[string]int)
+	}
 	d.deviceMetadata[modelPID][variant]++
 }
+
+// DeviceInstance returns the instance the device with the passed id was added to the datacenter under, false if it
+// hasn't been added or was added before its instance was recorded.
+func (d *Datacenter) DeviceInstance(deviceId string) (int, bool) {
+	for _, device := range d.Devices {
+		if device.ID == deviceId {
+			return device.Instance, device.Instance > 0
+		}
+	}
+	return 0, false
+}
+
+// HasDevice returns true if the device with the passed id has been added to the datacenter.
+func (d *Datacenter) HasDevice(deviceId string) bool {
+	for _, device := range d.Devices {
+		if device.ID == deviceId {
+			return true
+		}
+	}
+	return false
+}
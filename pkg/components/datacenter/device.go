@@ -11,6 +11,8 @@ type Device struct {
 	Hostname string
 	// the elevation of the device. corresponds to the first RU that this device occupies.
 	Elevation int
+	// the half of the width of its rack a half width device is racked in.
+	Side Side
 	// the designation given to this device. (primary/secondary/unspecified)
 	Designation Designation
 	// the cluster number of the device. (a value of 0 is unclustered)
@@ -20,6 +22,8 @@ type Device struct {
 	Instance int
 	// the hardware model that is this device.
 	Model hardware.HardwareModel
+	// the variant of the hardware model, taken from the device template the device was created with.
+	Variant string
 
 	// the categories this device falls under.
 	Categories []string
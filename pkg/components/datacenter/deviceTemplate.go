@@ -28,6 +28,9 @@ type DeviceTemplate struct {
 
 	// the hardware model that this template creates devices with.
 	Model hardware.HardwareModel
+	// how devices created using this template are placed in a rack when no elevation is given. (top-down if
+	// unspecified)
+	Placement Placement
 }
 
 func NewDeviceTemplate() *DeviceTemplate {
@@ -47,6 +50,21 @@ func (t *DeviceTemplate) TemplateHostname(vars HostnameTemplateVars) (string, er
 	return strings.ToUpper(hostname), nil
 }
 
+// PlacementStrategy returns the PlacementStrategy devices created using the template are placed with, the placement
+// passed overrides the placement of the template unless it is UnknownPlacement.
+func (t *DeviceTemplate) PlacementStrategy(placement Placement) PlacementStrategy {
+	if placement == "" || placement == UnknownPlacement {
+		placement = t.Placement
+	}
+	return NewPlacementStrategy(placement)
+}
+
+// HostnameDependsOnPlacement returns true if the hostnames of the template depend on the rack or elevation of the
+// device, so that they change when the device is moved.
+func (t *DeviceTemplate) HostnameDependsOnPlacement() bool {
+	return templateHasVar(t.HostnameTemplate, rackTemplateVar) || templateHasVar(t.HostnameTemplate, elevationTemplateVar)
+}
+
 type HostnameTemplateVars struct {
 	site        string
 	function    Function
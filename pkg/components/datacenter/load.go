@@ -0,0 +1,102 @@
+package datacenter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+var (
+	ErrRackOverloaded     = errors.New("rack overloaded")
+	ErrHeavyDeviceTooHigh = errors.New("heavy device too high")
+)
+
+// RackLoad is the weight a rack is rated to bear.
+type RackLoad struct {
+	// the weight the rack bears standing on its feet or leveling casters. (a zero Value is unrated)
+	StaticRating units.Value
+	// the weight the rack bears while it is rolled on its casters, racks are only checked against it when they are
+	// reported. (a zero Value is unrated)
+	DynamicRating units.Value
+	// devices that weigh at least HeavyDeviceWeight can't be racked above HeavyDeviceMaxElevation. (a zero Value
+	// disables the rule)
+	HeavyDeviceWeight       units.Value
+	HeavyDeviceMaxElevation int
+}
+
+// IsHeavy returns true if the heavy gear rule of the RackLoad applies to the model.
+func (l RackLoad) IsHeavy(model hardware.HardwareModel) bool {
+	return !l.HeavyDeviceWeight.IsZero() && kilograms(model.Weight) >= kilograms(l.HeavyDeviceWeight)
+}
+
+// kilograms returns the weight in kilograms, values that aren't a mass weigh nothing. weights are checked to be a mass
+// before they're recorded.
+func kilograms(weight units.Value) float64 {
+	kg, err := weight.In(units.KiloGram)
+	if err != nil {
+		return 0
+	}
+	return kg
+}
+
+// Weight returns the weight of the devices racked in the Rack, in kilograms.
+func (r *Rack) Weight() float64 {
+	return r.weightWithout(nil)
+}
+
+func (r *Rack) weightWithout(ignored *Device) float64 {
+	var weight float64
+	for _, device := range r.RackedDevices() {
+		if device != ignored {
+			weight += kilograms(device.Model.Weight)
+		}
+	}
+	return weight
+}
+
+// OverDynamicRating returns true if the devices racked in the Rack weigh more than its dynamic rating, the Rack then
+// can't be rolled loaded.
+func (r *Rack) OverDynamicRating() bool {
+	return !r.Load.DynamicRating.IsZero() && r.Weight() > kilograms(r.Load.DynamicRating)
+}
+
+// CheckLoad returns an error if racking a device of the model at elevation el pushes the weight of the Rack over its
+// static rating, or puts a heavy device above the highest elevation heavy devices are allowed at. an elevation of 0
+// only checks the weight of the Rack.
+func (r *Rack) CheckLoad(model hardware.HardwareModel, el int) error {
+	return r.checkLoad(model, el, nil)
+}
+
+// checkLoad is like CheckLoad but the weight of the ignored device, which is being moved within the Rack, isn't
+// counted twice.
+func (r *Rack) checkLoad(model hardware.HardwareModel, el int, ignored *Device) error {
+	if !r.Load.StaticRating.IsZero() {
+		rating := kilograms(r.Load.StaticRating)
+		if weight := r.weightWithout(ignored) + kilograms(model.Weight); weight > rating {
+			return fmt.Errorf("%w: rack {%s} would bear %.1fkg of its %.1fkg static rating", ErrRackOverloaded, r.Name, weight, rating)
+		}
+	}
+
+	if el > r.Load.HeavyDeviceMaxElevation && r.Load.IsHeavy(model) {
+		return fmt.Errorf("%w: a device of %s can't be racked above elevation %d of rack {%s}, not at %d", ErrHeavyDeviceTooHigh, model.Weight, r.Load.HeavyDeviceMaxElevation, r.Name, el)
+	}
+	return nil
+}
+
+// CheckRatings returns an error if the devices racked in the Rack break the load ratings, their weight is over its static
+// rating or a heavy device is racked above the highest elevation heavy devices are allowed at.
+func (r *Rack) CheckRatings(load RackLoad) error {
+	if !load.StaticRating.IsZero() {
+		if weight, rating := r.Weight(), kilograms(load.StaticRating); weight > rating {
+			return fmt.Errorf("%w: the devices of rack {%s} weigh %.1fkg, over its %.1fkg static rating", ErrRackOverloaded, r.Name, weight, rating)
+		}
+	}
+	for _, device := range r.RackedDevices() {
+		if device.Elevation > load.HeavyDeviceMaxElevation && load.IsHeavy(device.Model) {
+			return fmt.Errorf("%w: device {%s} of %s is racked at elevation %d of rack {%s}, above %d", ErrHeavyDeviceTooHigh, device.ID, device.Model.Weight, device.Elevation, r.Name, load.HeavyDeviceMaxElevation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package datacenter
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// newRackLoad returns the load ratings of the masses, an empty mass is unrated.
+func newRackLoad(t *testing.T, staticRating, dynamicRating, heavyDeviceWeight string, heavyDeviceMaxElevation int) RackLoad {
+	t.Helper()
+
+	mass := func(s string) units.Value {
+		value, err := units.ParseMass(s)
+		if err != nil {
+			t.Fatalf("ParseMass: %v", err)
+		}
+		return value
+	}
+	return RackLoad{
+		StaticRating:            mass(staticRating),
+		DynamicRating:           mass(dynamicRating),
+		HeavyDeviceWeight:       mass(heavyDeviceWeight),
+		HeavyDeviceMaxElevation: heavyDeviceMaxElevation,
+	}
+}
+
+func TestRackWeightInPoundsAndKilograms(t *testing.T) {
+	rack := newSmallRack(42)
+	if err := rack.RackDeviceAt(newTestDevice(t, "srv1", "srv", 2, "20kg"), 10); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups1", "ups", 2, "100lb"), 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+
+	// a pound is 0.45359237kg.
+	if weight := rack.Weight(); math.Abs(weight-65.359237) > 1e-9 {
+		t.Fatalf("expected the devices to weigh 65.359237kg, got %f", weight)
+	}
+}
+
+func TestRackStaticRating(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   string
+		weight   string
+		expected error
+	}{
+		{name: "within the rating", rating: "70kg", weight: "4kg"},
+		{name: "over the rating", rating: "70kg", weight: "5kg", expected: ErrRackOverloaded},
+		// 150lb is 68.04kg.
+		{name: "within the rating in pounds", rating: "150lb", weight: "2kg"},
+		{name: "over the rating in pounds", rating: "150lb", weight: "6lb", expected: ErrRackOverloaded},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rack := newSmallRack(42)
+			if err := rack.RackDeviceAt(newTestDevice(t, "srv1", "srv", 2, "20kg"), 10); err != nil {
+				t.Fatalf("RackDeviceAt: %v", err)
+			}
+			if err := rack.RackDeviceAt(newTestDevice(t, "ups1", "ups", 2, "100lb"), 2); err != nil {
+				t.Fatalf("RackDeviceAt: %v", err)
+			}
+			rack.Load = newRackLoad(t, test.rating, "", "", 0)
+
+			device := newTestDevice(t, "srv2", "srv", 1, test.weight)
+			if _, ok := rack.CanFitDevice(device.Model, nil); ok != (test.expected == nil) {
+				t.Fatalf("expected the device to fit %v", test.expected == nil)
+			}
+			if err := rack.RackDeviceAt(device, 20); !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestRackDynamicRating(t *testing.T) {
+	rack := newSmallRack(42)
+	// the dynamic rating is only reported, a rack loaded over it isn't rolled into place.
+	rack.Load = newRackLoad(t, "200lb", "40kg", "", 0)
+
+	if err := rack.RackDeviceAt(newTestDevice(t, "srv1", "srv", 2, "20kg"), 10); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if rack.OverDynamicRating() {
+		t.Fatalf("expected the rack within its dynamic rating at %.1fkg", rack.Weight())
+	}
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups1", "ups", 2, "50lb"), 2); err != nil {
+		t.Fatalf("expected the device within the static rating, got %v", err)
+	}
+	if !rack.OverDynamicRating() {
+		t.Fatalf("expected the rack over its dynamic rating at %.1fkg", rack.Weight())
+	}
+
+	// 20kg + 50lb + 50lb is 65.36kg, within the 90.72kg static rating; a fourth device isn't.
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups2", "ups", 2, "50lb"), 4); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups3", "ups", 2, "60lb"), 6); !errors.Is(err, ErrRackOverloaded) {
+		t.Fatalf("expected %v, got %v", ErrRackOverloaded, err)
+	}
+}
+
+func TestHeavyDeviceMaxElevation(t *testing.T) {
+	tests := []struct {
+		name        string
+		heavyWeight string
+		weight      string
+		heavy       bool
+	}{
+		{name: "heavy device", heavyWeight: "25kg", weight: "30kg", heavy: true},
+		{name: "at the heavy weight", heavyWeight: "25kg", weight: "25kg", heavy: true},
+		{name: "light device", heavyWeight: "25kg", weight: "24kg"},
+		// 50lb is 22.68kg, 55lb is 24.95kg.
+		{name: "heavy device in pounds", heavyWeight: "22kg", weight: "50lb", heavy: true},
+		{name: "heavy weight in pounds", heavyWeight: "55lb", weight: "24kg"},
+		{name: "both in pounds", heavyWeight: "55lb", weight: "60lb", heavy: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rack := newSmallRack(42)
+			rack.Load = newRackLoad(t, "", "", test.heavyWeight, 10)
+			model := newTestDevice(t, "ups", "ups", 2, test.weight).Model
+
+			// automatic placement puts a heavy device at the highest elevation it is allowed at.
+			expected := 42
+			if test.heavy {
+				expected = 10
+			}
+			if el, _ := rack.CanFitDevice(model, nil); el != expected {
+				t.Fatalf("expected the device to fit at %d, got %d", expected, el)
+			}
+
+			var tooHigh error
+			if test.heavy {
+				tooHigh = ErrHeavyDeviceTooHigh
+			}
+			if err := rack.RackDeviceAt(newTestDevice(t, "ups1", "ups", 2, test.weight), 30); !errors.Is(err, tooHigh) {
+				t.Fatalf("expected %v at 30, got %v", tooHigh, err)
+			}
+			if err := rack.RackDeviceAt(newTestDevice(t, "ups2", "ups", 2, test.weight), 10); err != nil {
+				t.Fatalf("expected the device to be racked at 10, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckRatings(t *testing.T) {
+	rack := newSmallRack(42)
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups1", "ups", 2, "30kg"), 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if err := rack.RackDeviceAt(newTestDevice(t, "ups2", "ups", 2, "60lb"), 40); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		load     RackLoad
+		expected error
+	}{
+		{name: "within the ratings", load: newRackLoad(t, "100kg", "50kg", "25kg", 40)},
+		{name: "over the static rating", load: newRackLoad(t, "100lb", "", "", 0), expected: ErrRackOverloaded},
+		// ups2 weighs 27.2kg and is racked at 40.
+		{name: "heavy device racked high", load: newRackLoad(t, "", "", "25kg", 39), expected: ErrHeavyDeviceTooHigh},
+		{name: "light device racked high", load: newRackLoad(t, "", "", "61lb", 10)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := rack.CheckRatings(test.load); !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+		})
+	}
+}
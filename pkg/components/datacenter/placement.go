@@ -0,0 +1,236 @@
+package datacenter
+
+import (
+	"math"
+	"strings"
+)
+
+type Placement string
+
+const (
+	UnknownPlacement Placement = "unspecified"
+	// TopDownPlacement racks a device at the highest elevation it fits at.
+	TopDownPlacement Placement = "top-down"
+	// BottomUpPlacement racks a device at the lowest elevation it fits at.
+	BottomUpPlacement Placement = "bottom-up"
+	// BestFitPlacement racks a device in the smallest gap of free RU(s) it fits in, leaving the larger gaps to larger
+	// devices.
+	BestFitPlacement Placement = "best-fit"
+	// PairAdjacentPlacement racks the primary or the secondary device of a pair next to its partner: the device racked
+	// in the rack of the same model and cluster with the other designation.
+	PairAdjacentPlacement Placement = "pair-adjacent"
+	// BalancedPlacement spreads the weight of the devices and the power they draw over the height of the rack.
+	BalancedPlacement Placement = "balanced"
+)
+
+// ParsePlacement parses the passed string into a Placement.
+// UnknownPlacement is returned if the input doesn't match any valid Placement values.
+func ParsePlacement(s string) Placement {
+	switch strings.ToLower(s) {
+	case "top-down", "topdown", "top":
+		return TopDownPlacement
+	case "bottom-up", "bottomup", "bottom":
+		return BottomUpPlacement
+	case "best-fit", "bestfit":
+		return BestFitPlacement
+	case "pair-adjacent", "pair":
+		return PairAdjacentPlacement
+	case "balanced", "balance":
+		return BalancedPlacement
+	}
+
+	// unrecognized input
+	return UnknownPlacement
+}
+
+// PlacementStrategy picks the elevation a device is racked at when none is given.
+type PlacementStrategy interface {
+	// Place returns the elevation the device is racked at in the rack, one of the candidates: the elevations the device
+	// fits at, from the highest to the lowest. there is always at least one candidate.
+	Place(rack *Rack, device *Device, candidates []int) int
+}
+
+// NewPlacementStrategy returns the PlacementStrategy of the Placement, devices are placed top-down for
+// UnknownPlacement.
+func NewPlacementStrategy(p Placement) PlacementStrategy {
+	switch p {
+	case BottomUpPlacement:
+		return bottomUp{}
+	case BestFitPlacement:
+		return bestFit{}
+	case PairAdjacentPlacement:
+		return pairAdjacent{}
+	case BalancedPlacement:
+		return balanced{}
+	}
+	return topDown{}
+}
+
+// FindPlacement returns the elevation the strategy racks the device at, and true if the Rack has a valid range of
+// RU(s) for the device, see CanFitDevice. the slots of a device racked in the Rack are considered free, it is moved.
+func (r *Rack) FindPlacement(device *Device, strategy PlacementStrategy) (int, bool) {
+	candidates := r.candidates(device.Model, device.Categories, r.racked(device))
+	if len(candidates) == 0 {
+		return 0, false
+	}
+	return strategy.Place(r, device, candidates), true
+}
+
+// racked returns the device if it is racked in the Rack, or nil.
+func (r *Rack) racked(device *Device) *Device {
+	if racked, ok := r.GetDevice(device.ID); ok {
+		return racked
+	}
+	return nil
+}
+
+type topDown struct{}
+
+func (topDown) Place(_ *Rack, _ *Device, candidates []int) int {
+	return candidates[0]
+}
+
+type bottomUp struct{}
+
+func (bottomUp) Place(_ *Rack, _ *Device, candidates []int) int {
+	return candidates[len(candidates)-1]
+}
+
+type bestFit struct{}
+
+// Place returns the highest candidate in the smallest gap of free RU(s), the gap is free for the slots the device
+// occupies and outside the RU(s) reserved for other categories.
+func (bestFit) Place(rack *Rack, device *Device, candidates []int) int {
+	ignored := rack.racked(device)
+	best, smallest := candidates[0], math.MaxInt
+	for _, el := range candidates {
+		if gap := rack.gap(device, el, ignored); gap < smallest {
+			best, smallest = el, gap
+		}
+	}
+	return best
+}
+
+// gap returns the number of free RU(s) in the gap the device fits in at elevation el.
+func (r *Rack) gap(device *Device, el int, ignored *Device) int {
+	side, _ := r.freeSide(device.Model, el, "", ignored)
+	unit := device.Model
+	unit.FormFactor = 1
+	free := func(ru int) bool {
+		_, reserved := r.reservedFor(device.Categories, ru, 1)
+		return !reserved && r.canFitAt(unit, ru, side, ignored)
+	}
+
+	gap := device.Model.FormFactor
+	for ru := el + 1; ru <= len(r.Units) && free(ru); ru++ {
+		gap++
+	}
+	for ru := el - device.Model.FormFactor; ru >= 1 && free(ru); ru-- {
+		gap++
+	}
+	return gap
+}
+
+type pairAdjacent struct{}
+
+// Place returns the candidate nearest to the partner of the device, or the highest candidate if the device has no
+// partner racked in the rack.
+func (pairAdjacent) Place(rack *Rack, device *Device, candidates []int) int {
+	partner, ok := rack.partner(device)
+	if !ok {
+		return candidates[0]
+	}
+
+	best, nearest := candidates[0], math.MaxInt
+	for _, el := range candidates {
+		if distance := spacing(el, device.Model.FormFactor, partner.Elevation, partner.Model.FormFactor); distance < nearest {
+			best, nearest = el, distance
+		}
+	}
+	return best
+}
+
+// partner returns the device racked in the Rack the device is paired with: a device of the same model and cluster
+// with the other designation.
+func (r *Rack) partner(device *Device) (*Device, bool) {
+	var other Designation
+	switch device.Designation {
+	case PrimaryDesignation:
+		other = SecondaryDesignation
+	case SecondaryDesignation:
+		other = PrimaryDesignation
+	default:
+		return nil, false
+	}
+
+	for _, racked := range r.RackedDevices() {
+		if racked.ID != device.ID && racked.Designation == other && racked.Model.ID != "" &&
+			racked.Model.ID == device.Model.ID && racked.Cluster == device.Cluster {
+			return racked, true
+		}
+	}
+	return nil, false
+}
+
+// spacing returns the number of RU(s) between a device of formFactor racked at el and a device of otherFormFactor
+// racked at otherEl, 0 if they are adjacent or share RU(s).
+func spacing(el, formFactor, otherEl, otherFormFactor int) int {
+	switch bottom, otherBottom := el-(formFactor-1), otherEl-(otherFormFactor-1); {
+	case bottom > otherEl:
+		return bottom - otherEl - 1
+	case otherBottom > el:
+		return otherBottom - el - 1
+	}
+	return 0
+}
+
+type balanced struct{}
+
+// Place returns the candidate furthest from the load of the devices racked in the rack, the lowest one if the rack is
+// empty. the load of a device is its share of the weight of the devices and of the power they draw, every device
+// weighs the same if none of their weights and draws are known.
+func (balanced) Place(rack *Rack, device *Device, candidates []int) int {
+	devices := make([]*Device, 0)
+	var weight, power float64
+	for _, racked := range rack.RackedDevices() {
+		if racked.ID == device.ID {
+			continue
+		}
+		devices = append(devices, racked)
+		weight += kilograms(racked.Model.Weight)
+		power += float64(racked.Model.Power.Peak())
+	}
+	load := func(d *Device) float64 {
+		if weight == 0 && power == 0 {
+			return 1
+		}
+		var l float64
+		if weight > 0 {
+			l += kilograms(d.Model.Weight) / weight
+		}
+		if power > 0 {
+			l += float64(d.Model.Power.Peak()) / power
+		}
+		return l
+	}
+
+	best, lightest := candidates[len(candidates)-1], math.Inf(1)
+	// candidates are tried from the lowest, so that ties keep the weight low.
+	for i := len(candidates) - 1; i >= 0; i-- {
+		el := candidates[i]
+		var score float64
+		for _, racked := range devices {
+			distance := math.Abs(center(el, device.Model.FormFactor) - center(racked.Elevation, racked.Model.FormFactor))
+			score += load(racked) / (1 + distance)
+		}
+		if score < lightest {
+			best, lightest = el, score
+		}
+	}
+	return best
+}
+
+// center returns the middle of the RU(s) a device of formFactor racked at el occupies.
+func center(el, formFactor int) float64 {
+	return float64(el) - float64(formFactor-1)/2
+}
@@ -0,0 +1,169 @@
+package datacenter
+
+import (
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
+
+// newTestDevice returns a device of the model, form factor and weight.
+func newTestDevice(t *testing.T, id, modelId string, formFactor int, weight string) *Device {
+	t.Helper()
+
+	mass, err := units.ParseMass(weight)
+	if err != nil {
+		t.Fatalf("ParseMass: %v", err)
+	}
+	device := NewDevice()
+	device.ID = id
+	device.Model = hardware.HardwareModel{ID: modelId, FormFactor: formFactor, Weight: mass}
+	return device
+}
+
+// newPlacementRack returns a 12U rack with gaps of free RU(s) of every size:
+//
+//	12    reserved for patch panels
+//	11    ups1 (40kg)
+//	10-9  free
+//	8     sw1, the primary switch of cluster 1
+//	7     free
+//	6     srv1
+//	5-3   free
+//	2     ups2 (40kg)
+//	1     free
+func newPlacementRack(t *testing.T) *Rack {
+	t.Helper()
+
+	rack := NewRack()
+	rack.Name = "a01"
+	rack.SetSize(12)
+
+	rus, err := ranges.ParseRange("12")
+	if err != nil {
+		t.Fatalf("ParseRange: %v", err)
+	}
+	rack.Reserve(Reservation{RUs: rus, Reason: "patching", Categories: []string{"patch-panel"}})
+
+	primary := newTestDevice(t, "sw1", "sw", 1, "5kg")
+	primary.Designation = PrimaryDesignation
+	primary.Cluster = 1
+	devices := map[int]*Device{
+		11: newTestDevice(t, "ups1", "ups", 1, "40kg"),
+		8:  primary,
+		6:  newTestDevice(t, "srv1", "srv", 1, "5kg"),
+		2:  newTestDevice(t, "ups2", "ups", 1, "40kg"),
+	}
+	for el, device := range devices {
+		if err = rack.RackDeviceAt(device, el); err != nil {
+			t.Fatalf("RackDeviceAt: %v", err)
+		}
+	}
+	return rack
+}
+
+// newSecondarySwitch returns the secondary switch of cluster 1, the partner of sw1.
+func newSecondarySwitch(t *testing.T) *Device {
+	device := newTestDevice(t, "sw2", "sw", 1, "5kg")
+	device.Designation = SecondaryDesignation
+	device.Cluster = 1
+	device.Categories = []string{"network"}
+	return device
+}
+
+func TestPlacementStrategies(t *testing.T) {
+	tests := []struct {
+		placement Placement
+		expected  int
+	}{
+		// the highest free RU outside the reservation.
+		{placement: TopDownPlacement, expected: 10},
+		{placement: UnknownPlacement, expected: 10},
+		{placement: BottomUpPlacement, expected: 1},
+		// 7 and 1 are the gaps of a single RU, the highest of them is taken.
+		{placement: BestFitPlacement, expected: 7},
+		// 9 and 7 are both adjacent to sw1, the highest of them is taken.
+		{placement: PairAdjacentPlacement, expected: 9},
+		// the furthest from the UPSes, the switch and the server are light.
+		{placement: BalancedPlacement, expected: 5},
+	}
+	for _, test := range tests {
+		t.Run(string(test.placement), func(t *testing.T) {
+			rack := newPlacementRack(t)
+			device := newSecondarySwitch(t)
+
+			el, ok := rack.FindPlacement(device, NewPlacementStrategy(test.placement))
+			if !ok {
+				t.Fatalf("expected the device to fit")
+			}
+			if el != test.expected {
+				t.Fatalf("expected the device at %d, got %d", test.expected, el)
+			}
+		})
+	}
+}
+
+func TestPlacementStrategiesInReservation(t *testing.T) {
+	tests := []struct {
+		placement Placement
+		expected  int
+	}{
+		// the reserved RU is free for a patch panel.
+		{placement: TopDownPlacement, expected: 12},
+		{placement: BottomUpPlacement, expected: 1},
+		// 12, 7 and 1 are the gaps of a single RU.
+		{placement: BestFitPlacement, expected: 12},
+	}
+	for _, test := range tests {
+		t.Run(string(test.placement), func(t *testing.T) {
+			rack := newPlacementRack(t)
+			device := newTestDevice(t, "pp1", "pp", 1, "1kg")
+			device.Categories = []string{"Patch-Panel"}
+
+			if el, _ := rack.FindPlacement(device, NewPlacementStrategy(test.placement)); el != test.expected {
+				t.Fatalf("expected the patch panel at %d, got %d", test.expected, el)
+			}
+		})
+	}
+}
+
+func TestPlacementStrategyOfTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Placement
+		command  Placement
+		expected int
+	}{
+		{name: "default", expected: 10},
+		{name: "template", template: BestFitPlacement, command: UnknownPlacement, expected: 7},
+		{name: "command", template: BestFitPlacement, command: BottomUpPlacement, expected: 1},
+		{name: "command without template", command: PairAdjacentPlacement, expected: 9},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rack := newPlacementRack(t)
+			template := NewDeviceTemplate()
+			template.Placement = test.template
+
+			el, _ := rack.FindPlacement(newSecondarySwitch(t), template.PlacementStrategy(test.command))
+			if el != test.expected {
+				t.Fatalf("expected the device at %d, got %d", test.expected, el)
+			}
+		})
+	}
+}
+
+func TestPlacementOfRackedDevice(t *testing.T) {
+	rack := newPlacementRack(t)
+	srv, _ := rack.GetDevice("srv1")
+
+	// the RU the server occupies is free when it is moved, so 7 joins the gap of 5-3 and 1 is the only gap of a single
+	// RU left.
+	if el, _ := rack.FindPlacement(srv, NewPlacementStrategy(BestFitPlacement)); el != 1 {
+		t.Fatalf("expected the server at 1, got %d", el)
+	}
+	if el, _ := rack.FindPlacement(srv, NewPlacementStrategy(BalancedPlacement)); el != 6 {
+		t.Fatalf("expected the server to stay at 6, got %d", el)
+	}
+}
@@ -0,0 +1,222 @@
+package datacenter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+type PowerPolicy string
+
+const (
+	UnknownPowerPolicy PowerPolicy = "unspecified"
+	// RejectOverBudget refuses to rack a device that draws more from a feed than its budget.
+	RejectOverBudget PowerPolicy = "reject"
+	// WarnOverBudget racks the device, the feed is reported as over its budget.
+	WarnOverBudget PowerPolicy = "warn"
+)
+
+// ParsePowerPolicy parses the passed string into a PowerPolicy.
+// UnknownPowerPolicy is returned if the input doesn't match any valid PowerPolicy values.
+func ParsePowerPolicy(s string) PowerPolicy {
+	switch strings.ToLower(s) {
+	case "reject":
+		return RejectOverBudget
+	case "warn":
+		return WarnOverBudget
+	}
+
+	// unrecognized input
+	return UnknownPowerPolicy
+}
+
+// DefaultDeratingThreshold keeps the continuous load of a feed at 80% of the rating of its circuit.
+const DefaultDeratingThreshold = 0.8
+
+var ErrPowerBudgetExceeded = errors.New("power budget exceeded")
+
+// PowerFeed is a circuit feeding the power distribution unit(s) of a rack.
+type PowerFeed struct {
+	// the name of the feed, e.g. 'A' or 'B'.
+	Name string `json:"name"`
+	// the number of phases of the circuit. (1 or 3)
+	Phases int `json:"phases"`
+	// the rating of the circuit, in amperes.
+	Amperage int `json:"amperage"`
+	// the voltage of the circuit, in volts. the voltage between two lines for a 3 phase circuit.
+	Voltage int `json:"voltage"`
+}
+
+// Capacity returns the power the feed can deliver, in watts.
+func (f PowerFeed) Capacity() float64 {
+	capacity := float64(f.Amperage) * float64(f.Voltage)
+	if f.Phases == 3 {
+		capacity *= math.Sqrt(3)
+	}
+	return capacity
+}
+
+// RackPower is the power available to a rack.
+type RackPower struct {
+	// the feeds of the rack. the power supplies of a device are plugged into the feeds in turn, a device with a single
+	// power supply is plugged into the first feed.
+	Feeds []PowerFeed
+	// the fraction of the capacity of a feed that may be drawn from it.
+	DeratingThreshold float64
+	// what racking a device that pushes a feed over its budget does.
+	Policy PowerPolicy
+}
+
+// Budget returns the power that may be drawn from the feed, in watts.
+func (p RackPower) Budget(feed PowerFeed) float64 {
+	return feed.Capacity() * p.DeratingThreshold
+}
+
+// FeedLoad is the power drawn from a feed by the devices of a rack.
+type FeedLoad struct {
+	Feed string `json:"feed"`
+	// the capacity and the budget of the feed, in watts.
+	Capacity float64 `json:"capacity"`
+	Budget   float64 `json:"budget"`
+	// the power drawn when every device draws its typical and its most power, in watts.
+	Typical float64 `json:"typical"`
+	Peak    float64 `json:"peak"`
+	// true if the peak draw is over the budget or the capacity of the feed.
+	OverBudget   bool `json:"overBudget"`
+	OverCapacity bool `json:"overCapacity"`
+}
+
+// FeedFailure is the load of the feeds of a rack that are left once one of them has failed.
+type FeedFailure struct {
+	// the name of the feed that failed.
+	Failed string     `json:"failed"`
+	Feeds  []FeedLoad `json:"feeds"`
+	// the ids of the devices whose every power supply is plugged into the failed feed.
+	Unpowered []string `json:"unpowered,omitempty"`
+}
+
+// PowerReport is the load of the feeds of a rack, and their load when any one of them fails.
+type PowerReport struct {
+	DeratingThreshold float64       `json:"deratingThreshold"`
+	Policy            PowerPolicy   `json:"policy"`
+	Feeds             []FeedLoad    `json:"feeds"`
+	Failures          []FeedFailure `json:"failures"`
+}
+
+// NewPowerReport reports the load of the devices on the feeds of a rack.
+func NewPowerReport(power RackPower, devices []*Device) PowerReport {
+	report := PowerReport{
+		DeratingThreshold: power.DeratingThreshold,
+		Policy:            power.Policy,
+		Feeds:             make([]FeedLoad, 0, len(power.Feeds)),
+		Failures:          make([]FeedFailure, 0, len(power.Feeds)),
+	}
+	if len(power.Feeds) == 0 {
+		return report
+	}
+
+	report.Feeds, _ = feedLoads(power, devices, -1)
+	// a rack fed by a single feed has nothing left when it fails.
+	if len(power.Feeds) < 2 {
+		return report
+	}
+	for failed, feed := range power.Feeds {
+		loads, unpowered := feedLoads(power, devices, failed)
+		report.Failures = append(report.Failures, FeedFailure{Failed: feed.Name, Feeds: loads, Unpowered: unpowered})
+	}
+	return report
+}
+
+// OverBudget returns true if a feed draws more than its budget while every feed is up.
+func (r PowerReport) OverBudget() bool {
+	for _, load := range r.Feeds {
+		if load.OverBudget {
+			return true
+		}
+	}
+	return false
+}
+
+// feedLoads returns the load of the feeds of the rack, except the failed one (-1 for none), and the ids of the devices
+// left without power. the draw of a device is shared between its power supplies that are plugged into a feed that is
+// up.
+func feedLoads(power RackPower, devices []*Device, failed int) ([]FeedLoad, []string) {
+	typical := make([]float64, len(power.Feeds))
+	peak := make([]float64, len(power.Feeds))
+	var unpowered []string
+	for _, device := range devices {
+		psus := device.Model.Power.Supplies()
+		powered := 0
+		for psu := 0; psu < psus; psu++ {
+			if psu%len(power.Feeds) != failed {
+				powered++
+			}
+		}
+		if powered == 0 {
+			unpowered = append(unpowered, device.ID)
+			continue
+		}
+
+		for psu := 0; psu < psus; psu++ {
+			if feed := psu % len(power.Feeds); feed != failed {
+				typical[feed] += float64(device.Model.Power.Typical) / float64(powered)
+				peak[feed] += float64(device.Model.Power.Peak()) / float64(powered)
+			}
+		}
+	}
+
+	loads := make([]FeedLoad, 0, len(power.Feeds))
+	for i, feed := range power.Feeds {
+		if i == failed {
+			continue
+		}
+		load := FeedLoad{
+			Feed:     feed.Name,
+			Capacity: feed.Capacity(),
+			Budget:   power.Budget(feed),
+			Typical:  typical[i],
+			Peak:     peak[i],
+		}
+		load.OverBudget = load.Peak > load.Budget
+		load.OverCapacity = load.Peak > load.Capacity
+		loads = append(loads, load)
+	}
+	return loads, unpowered
+}
+
+// PowerReport reports the load of the devices racked in the Rack on its feeds.
+func (r *Rack) PowerReport() PowerReport {
+	return NewPowerReport(r.Power, r.RackedDevices())
+}
+
+// CheckPower returns an error if racking the device in the Rack pushes the peak draw of one of its feeds over its
+// budget, regardless of the policy of the Rack. a device that is racked in the Rack already is only counted once.
+func (r *Rack) CheckPower(device *Device) error {
+	if len(r.Power.Feeds) == 0 {
+		return nil
+	}
+
+	devices := []*Device{device}
+	for _, racked := range r.RackedDevices() {
+		if racked.ID != device.ID {
+			devices = append(devices, racked)
+		}
+	}
+
+	loads, _ := feedLoads(r.Power, devices, -1)
+	for _, load := range loads {
+		if load.OverBudget {
+			return fmt.Errorf("%w: feed {%s} of rack {%s} would draw %.0fW of its %.0fW budget", ErrPowerBudgetExceeded, load.Feed, r.Name, load.Peak, load.Budget)
+		}
+	}
+	return nil
+}
+
+// enforcePower is like CheckPower but only returns an error if the Rack rejects devices over its budget.
+func (r *Rack) enforcePower(device *Device) error {
+	if r.Power.Policy == WarnOverBudget {
+		return nil
+	}
+	return r.CheckPower(device)
+}
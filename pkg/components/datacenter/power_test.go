@@ -0,0 +1,189 @@
+package datacenter
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+)
+
+// newPoweredDevice returns a 1U device drawing typical and max watts through the power supplies.
+func newPoweredDevice(id string, typical, max, psus int) *Device {
+	device := NewDevice()
+	device.ID = id
+	device.Model = hardware.HardwareModel{ID: id, FormFactor: 1, Power: hardware.PowerDraw{Typical: typical, Max: max, PSUs: psus}}
+	return device
+}
+
+// abFeeds returns the single phase 10A 240V feeds A and B, 2400W each, at 75% of their capacity.
+func abFeeds(policy PowerPolicy) RackPower {
+	return RackPower{
+		Feeds: []PowerFeed{
+			{Name: "A", Phases: 1, Amperage: 10, Voltage: 240},
+			{Name: "B", Phases: 1, Amperage: 10, Voltage: 240},
+		},
+		DeratingThreshold: 0.75,
+		Policy:            policy,
+	}
+}
+
+func TestPowerFeedCapacity(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     PowerFeed
+		expected float64
+	}{
+		{name: "single phase", feed: PowerFeed{Phases: 1, Amperage: 30, Voltage: 208}, expected: 6240},
+		{name: "three phase", feed: PowerFeed{Phases: 3, Amperage: 30, Voltage: 208}, expected: 6240 * math.Sqrt(3)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if capacity := test.feed.Capacity(); math.Abs(capacity-test.expected) > 1e-9 {
+				t.Fatalf("expected a capacity of %.2fW, got %.2fW", test.expected, capacity)
+			}
+			budget := RackPower{DeratingThreshold: DefaultDeratingThreshold}.Budget(test.feed)
+			if math.Abs(budget-test.expected*DefaultDeratingThreshold) > 1e-9 {
+				t.Fatalf("expected a budget of %.2fW, got %.2fW", test.expected*DefaultDeratingThreshold, budget)
+			}
+		})
+	}
+}
+
+func TestPowerReportDeratingThreshold(t *testing.T) {
+	tests := []struct {
+		name              string
+		deratingThreshold float64
+		peak              int
+		overBudget        bool
+		overCapacity      bool
+	}{
+		{name: "at the budget", deratingThreshold: 0.75, peak: 1800},
+		{name: "over the budget", deratingThreshold: 0.75, peak: 1801, overBudget: true},
+		{name: "without derating", deratingThreshold: 1, peak: 1801},
+		{name: "over the capacity", deratingThreshold: 1, peak: 2401, overBudget: true, overCapacity: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			power := abFeeds(RejectOverBudget)
+			power.Feeds = power.Feeds[:1]
+			power.DeratingThreshold = test.deratingThreshold
+
+			report := NewPowerReport(power, []*Device{newPoweredDevice("d1", 1000, test.peak, 1)})
+			if len(report.Feeds) != 1 {
+				t.Fatalf("expected the load of 1 feed, got %d", len(report.Feeds))
+			}
+			load := report.Feeds[0]
+			if load.Budget != 2400*test.deratingThreshold || load.Typical != 1000 || load.Peak != float64(test.peak) {
+				t.Fatalf("expected %dW of a %.0fW budget, got %+v", test.peak, 2400*test.deratingThreshold, load)
+			}
+			if load.OverBudget != test.overBudget || load.OverCapacity != test.overCapacity || report.OverBudget() != test.overBudget {
+				t.Fatalf("expected over budget %v and over capacity %v, got %+v", test.overBudget, test.overCapacity, load)
+			}
+			// a rack fed by a single feed has nothing left when it fails.
+			if len(report.Failures) != 0 {
+				t.Fatalf("expected no failures, got %+v", report.Failures)
+			}
+		})
+	}
+}
+
+func TestRackPowerReportFeedFailure(t *testing.T) {
+	rack := newSmallRack(2)
+	rack.Power = abFeeds(WarnOverBudget)
+	// srv1 draws from both feeds, sw1 only from A.
+	if err := rack.RackDeviceAt(newPoweredDevice("srv1", 1000, 3000, 2), 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if err := rack.RackDeviceAt(newPoweredDevice("sw1", 100, 300, 1), 1); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+
+	report := rack.PowerReport()
+	expected := map[string][2]float64{"A": {600, 1800}, "B": {500, 1500}}
+	for _, load := range report.Feeds {
+		if draw := expected[load.Feed]; load.Typical != draw[0] || load.Peak != draw[1] || load.OverBudget {
+			t.Fatalf("expected feed %s to draw %v within its budget, got %+v", load.Feed, draw, load)
+		}
+	}
+	if report.OverBudget() {
+		t.Fatalf("expected the rack within its budget")
+	}
+
+	if len(report.Failures) != 2 {
+		t.Fatalf("expected the failure of both feeds, got %+v", report.Failures)
+	}
+	tests := []struct {
+		failed    string
+		feed      string
+		typical   float64
+		peak      float64
+		unpowered []string
+	}{
+		// srv1 draws all of its power from B, sw1 is left without power.
+		{failed: "A", feed: "B", typical: 1000, peak: 3000, unpowered: []string{"sw1"}},
+		{failed: "B", feed: "A", typical: 1100, peak: 3300},
+	}
+	for i, test := range tests {
+		failure := report.Failures[i]
+		if failure.Failed != test.failed || len(failure.Feeds) != 1 {
+			t.Fatalf("expected the failure of %s to leave one feed, got %+v", test.failed, failure)
+		}
+		load := failure.Feeds[0]
+		if load.Feed != test.feed || load.Typical != test.typical || load.Peak != test.peak {
+			t.Fatalf("expected feed %s to draw %.0fW/%.0fW when %s fails, got %+v", test.feed, test.typical, test.peak, test.failed, load)
+		}
+		if !load.OverBudget || !load.OverCapacity {
+			t.Fatalf("expected feed %s over its budget and capacity when %s fails, got %+v", test.feed, test.failed, load)
+		}
+		if len(failure.Unpowered) != len(test.unpowered) || (len(test.unpowered) > 0 && failure.Unpowered[0] != test.unpowered[0]) {
+			t.Fatalf("expected %v to be left without power when %s fails, got %v", test.unpowered, test.failed, failure.Unpowered)
+		}
+	}
+}
+
+func TestRackPowerPolicy(t *testing.T) {
+	tests := []struct {
+		policy   PowerPolicy
+		expected error
+	}{
+		{policy: RejectOverBudget, expected: ErrPowerBudgetExceeded},
+		{policy: UnknownPowerPolicy, expected: ErrPowerBudgetExceeded},
+		{policy: WarnOverBudget},
+	}
+	for _, test := range tests {
+		t.Run(string(test.policy), func(t *testing.T) {
+			rack := newSmallRack(2)
+			rack.Power = abFeeds(test.policy)
+			if err := rack.RackDeviceAt(newPoweredDevice("srv1", 1000, 3000, 2), 2); err != nil {
+				t.Fatalf("RackDeviceAt: %v", err)
+			}
+
+			// 1500W + 400W on feed A is over its 1800W budget.
+			device := newPoweredDevice("srv2", 200, 400, 1)
+			if err := rack.CheckPower(device); !errors.Is(err, ErrPowerBudgetExceeded) {
+				t.Fatalf("expected %v whatever the policy, got %v", ErrPowerBudgetExceeded, err)
+			}
+			if err := rack.RackDeviceAt(device, 1); !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, err)
+			}
+			if _, racked := rack.GetDevice("srv2"); racked != (test.expected == nil) {
+				t.Fatalf("expected the device racked %v", test.expected == nil)
+			}
+			if rack.PowerReport().OverBudget() != (test.expected == nil) {
+				t.Fatalf("expected the rack over its budget only if the device is racked")
+			}
+		})
+	}
+
+	// a racked device is only counted once.
+	rack := newSmallRack(2)
+	rack.Power = abFeeds(RejectOverBudget)
+	srv := newPoweredDevice("srv1", 1000, 3000, 2)
+	if err := rack.RackDeviceAt(srv, 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if err := rack.CheckPower(srv); err != nil {
+		t.Fatalf("expected the racked device within the budget, got %v", err)
+	}
+}
@@ -3,6 +3,8 @@ package datacenter
 import (
 	"errors"
 	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 )
 
 // Rack represents a rack.
@@ -14,116 +16,276 @@ type Rack struct {
 	Name string
 	// the number of total RUs the rack has. (default 45)
 	Size int
+	// the power feeds of the rack, devices aren't checked against a rack without feeds.
+	Power RackPower
+	// the weight the rack is rated to bear, devices aren't checked against an unrated rack.
+	Load RackLoad
+	// the ranges of RU(s) of the rack devices are only racked in automatically if they fall under their categories.
+	Reservations []Reservation
 
 	// the datacenterAggregate this rack belongs to.
 	Datacenter *Datacenter
-	// the devices that are racked here.
-	Devices []*Device
+	// the RU(s) of the rack and the devices that are racked in them, indexed by RU - 1.
+	Units []RackUnit
 }
 
 const defaultRackSize = 45
 
 func NewRack() *Rack {
 	return &Rack{
-		Devices: make([]*Device, 0),
+		Units: make([]RackUnit, 0),
 	}
 }
 
-// CanFitDevice returns true if the Rack a valid range of RU(s) of size f.
-// a range is valid if it is: composed of sequential RU(s), all RU(s) in the range are not occupied.
-func (r *Rack) CanFitDevice(formFactor int) (int, bool) {
-	return r.canFitDevice(formFactor, r.Size, false)
+// GetDevice returns the device with the passed id and true if it is racked in the Rack.
+func (r *Rack) GetDevice(deviceId string) (*Device, bool) {
+	for _, unit := range r.Units {
+		for _, device := range unit {
+			if device != nil && device.ID == deviceId {
+				return device, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// RackedDevices returns the devices racked in the Rack, from the lowest elevation to the highest.
+func (r *Rack) RackedDevices() []*Device {
+	devices := make([]*Device, 0)
+	// a device occupies a slot of every RU it fills, only return it once.
+	seen := make(map[*Device]bool)
+	for _, unit := range r.Units {
+		for _, device := range unit {
+			if device == nil || seen[device] {
+				continue
+			}
+			seen[device] = true
+			devices = append(devices, device)
+		}
+	}
+	return devices
+}
+
+// SetSize sets the number of RUs of the rack and empties it.
+func (r *Rack) SetSize(size int) {
+	r.Size = size
+	r.Units = make([]RackUnit, size)
+}
+
+// CanFitDevice returns the highest elevation a device of the model can be racked at, and true if the Rack has a valid
+// range of RU(s) of the size of its form factor. a range is valid if it is: composed of sequential RU(s), the slots of
+// the RU(s) the mounting of the model occupies are not occupied, none of the RU(s) are reserved unless a device that
+// falls under the categories is allowed in them, and the device doesn't overload the Rack there.
+func (r *Rack) CanFitDevice(model hardware.HardwareModel, categories []string) (int, bool) {
+	el, _, ok := r.findFit(model, categories, nil)
+	return el, ok
 }
 
 // CanFitDeviceAt is like CanFitDevice but returns true only if there is a valid range of RU(s) beginning at the provided elevation(el).
-func (r *Rack) CanFitDeviceAt(formFactor int, el int) bool {
-	_, ok := r.canFitDevice(formFactor, el, true)
-	return ok
-}
-
-// canFitDevice searches for a consecutive range of open RU(s) that is of size formFactor, starting at startingElevation
-// and returns true on the first valid range found. the search satisfies the strict criteria if the first valid range found
-// starts at startingElevation.
-func (r *Rack) canFitDevice(formFactor int, startingElevation int, strict bool) (int, bool) {
-	var (
-		// tracks the number of consecutive open RU(s) in the currently tracked range.
-		openRUCount = 0
-		// the last index of the valid strict range
-		strictLimit = startingElevation - (formFactor - 1)
-	)
-	for i := startingElevation; i >= 0; i-- {
-		if r.Devices[i] == nil {
-			openRUCount++
-		} else if openRUCount > 0 {
-			// if the RU at i is not empty and there are open RU(s) being tracked
-			// reset the counter.
-			openRUCount = 0
+// a device racked at elevation el occupies the RU(s) el through el-(formFactor-1). reserved RU(s) are only skipped by
+// automatic placement, a device can be racked in them at an explicit elevation.
+func (r *Rack) CanFitDeviceAt(model hardware.HardwareModel, el int) bool {
+	_, ok := r.FreeSide(model, el)
+	return ok && r.checkLoad(model, el, nil) == nil
+}
+
+// FreeSide returns the side a device of the model is racked in at elevation el, and true if the slots it occupies
+// there are not occupied. a full width device has no side.
+func (r *Rack) FreeSide(model hardware.HardwareModel, el int) (Side, bool) {
+	return r.freeSide(model, el, "", nil)
+}
+
+// CanMoveDevice is like CanFitDevice but for a device racked in the Rack, the slots the device occupies are considered
+// free.
+func (r *Rack) CanMoveDevice(device *Device) (int, bool) {
+	el, _, ok := r.findFit(device.Model, device.Categories, device)
+	return el, ok
+}
+
+// CanMoveDeviceAt is like CanFitDeviceAt but for a device racked in the Rack, the slots the device occupies are
+// considered free.
+func (r *Rack) CanMoveDeviceAt(device *Device, el int) bool {
+	_, ok := r.freeSide(device.Model, el, "", device)
+	return ok && r.checkLoad(device.Model, el, device) == nil
+}
+
+// findFit returns the highest elevation and the side a device of the model that falls under the categories fits at,
+// skipping the RU(s) reserved for other categories. the ignored device is considered moved.
+func (r *Rack) findFit(model hardware.HardwareModel, categories []string, ignored *Device) (int, Side, bool) {
+	candidates := r.candidates(model, categories, ignored)
+	if len(candidates) == 0 {
+		return 0, "", false
+	}
+	side, _ := r.freeSide(model, candidates[0], "", ignored)
+	return candidates[0], side, true
+}
+
+// candidates returns the elevations a device of the model that falls under the categories fits at, from the highest
+// to the lowest, skipping the RU(s) reserved for other categories. the ignored device is considered moved.
+func (r *Rack) candidates(model hardware.HardwareModel, categories []string, ignored *Device) []int {
+	candidates := make([]int, 0)
+	for el := len(r.Units); el >= model.FormFactor; el-- {
+		if _, reserved := r.reservedFor(categories, el, model.FormFactor); reserved {
+			continue
 		}
+		if _, ok := r.freeSide(model, el, "", ignored); ok && r.checkLoad(model, el, ignored) == nil {
+			candidates = append(candidates, el)
+		}
+	}
+	return candidates
+}
 
-		switch {
-		case openRUCount >= formFactor:
-			// return the highest RU in the found range
-			return i + (openRUCount - 1), true
-		case i >= strictLimit && strict:
-			// the search has failed the strict criteria.
-			return 0, false
+// freeSide returns the first side, or the passed side if it isn't empty, a device of the model fits in at elevation
+// el.
+func (r *Rack) freeSide(model hardware.HardwareModel, el int, side Side, ignored *Device) (Side, bool) {
+	candidates := sides(model.Mounting)
+	if side != "" && model.Mounting.IsHalfWidth() {
+		candidates = []Side{side}
+	}
+	for _, candidate := range candidates {
+		if r.canFitAt(model, el, candidate, ignored) {
+			return candidate, true
 		}
 	}
-	return 0, false
+	return "", false
 }
 
-// RackDevice attempts to put the passed device into the Rack and returns an error if there is no space
-// available for the device.
-func (r *Rack) RackDevice(device *Device) error {
-	return r.rackDevice(device)
+// canFitAt returns true if the slots a device of the model racked in the side occupies in the RU(s) el through
+// el-(formFactor-1) are empty or occupied by the ignored device.
+func (r *Rack) canFitAt(model hardware.HardwareModel, el int, side Side, ignored *Device) bool {
+	if model.FormFactor <= 0 || el > len(r.Units) || el-(model.FormFactor-1) < 1 {
+		return false
+	}
+
+	for ru := el; ru > el-model.FormFactor; ru-- {
+		for _, slot := range slots(model.Mounting, side) {
+			// Units is indexed by RU - 1.
+			if occupant := r.Units[ru-1][slot]; occupant != nil && occupant != ignored {
+				return false
+			}
+		}
+	}
+	return true
 }
 
-// RackDeviceAt is like RackDevice but returns an error if the device cannot be racked at the elevation passed.
-func (r *Rack) RackDeviceAt(device *Device, el int) error {
-	// rackDevice accepts the desired index the device is to be inserted. elevation = index+1
-	return r.rackDevice(device, el-1)
+// FitError returns why a device of the model that falls under the categories can't be racked in the Rack at elevation
+// el (0 for anywhere), or nil if it can.
+func (r *Rack) FitError(model hardware.HardwareModel, categories []string, el int) error {
+	return r.fitError(model, categories, el, nil)
 }
 
-var ErrUnableToFitDevice = errors.New("unable to fit device")
+// MoveError is like FitError but for a device racked in the Rack, the slots the device occupies are considered free.
+func (r *Rack) MoveError(device *Device, el int) error {
+	return r.fitError(device.Model, device.Categories, el, device)
+}
 
-// rackDevice racks a device into the Rack and returns an error if no there is no space available for the device.
-// if a value is passed for 'at', the device will attempt to be racked at the passed index and returns an error
-// if it cannot fit there.
-func (r *Rack) rackDevice(device *Device, at ...int) error {
-	// the beginning and end of the RU range to insert the device at.
-	start := -1
+// CheckMove returns why the device can't be moved to elevation el of the Rack, or nil if it can. unlike a device racked
+// at an explicit elevation, a device isn't moved into RU(s) reserved for other categories, nor over the budget of a
+// Rack that rejects such devices. the device may be racked in the Rack already, its slots are then considered free and
+// its weight and draw are only counted once.
+func (r *Rack) CheckMove(device *Device, el int) error {
+	if err := r.fitError(device.Model, device.Categories, el, r.racked(device)); err != nil {
+		return err
+	}
+	if reservation, ok := r.reservedFor(device.Categories, el, device.Model.FormFactor); ok {
+		return fmt.Errorf("%w {%s} in rack {%s}: device {%s} can't be moved to elevation %d", ErrRUsReserved, reservation.RUs, r.Name, device.ID, el)
+	}
+	return r.enforcePower(device)
+}
 
-	if strict := len(at) > 0 && at[0] > 0; strict {
+func (r *Rack) fitError(model hardware.HardwareModel, categories []string, el int, ignored *Device) error {
+	if el > 0 {
+		if _, ok := r.freeSide(model, el, "", ignored); !ok {
+			return fmt.Errorf("%w: cannot fit a device of size %d at elevation %d", ErrUnableToFitDevice, model.FormFactor, el)
+		}
+		return r.checkLoad(model, el, ignored)
+	}
 
-		if !r.CanFitDeviceAt(device.Model.FormFactor, at[0]) {
-			return fmt.Errorf("%w: cannot fit a device of size %d at elevation %d", ErrUnableToFitDevice, device.Model.FormFactor, at[0])
+	if _, _, ok := r.findFit(model, categories, ignored); ok {
+		return nil
+	}
+	// report the load of the Rack, or the reservation, if the device fits in RU(s) it isn't allowed to use.
+	var reserved error
+	for el := len(r.Units); el >= model.FormFactor; el-- {
+		if _, ok := r.freeSide(model, el, "", ignored); !ok {
+			continue
 		}
-		start = at[0]
-
-	} else {
-
-		for i := len(r.Devices) - 1; i >= 0; i-- {
-			d := r.Devices[i]
-			if d == nil {
-				if r.CanFitDeviceAt(device.Model.FormFactor, i) {
-					start = i
-					break
-				}
-				i -= device.Model.FormFactor
+		if reservation, ok := r.reservedFor(categories, el, model.FormFactor); ok {
+			if reserved == nil {
+				reserved = fmt.Errorf("%w {%s} in rack {%s}: a device of size %d only fits in reserved RU(s)", ErrRUsReserved, reservation.RUs, r.Name, model.FormFactor)
 			}
-			i -= d.Model.FormFactor
+			continue
 		}
-
+		return r.checkLoad(model, el, ignored)
 	}
+	if reserved != nil {
+		return reserved
+	}
+	return fmt.Errorf("%w: could not find a valid range of RU(s) to fit a device of size %d", ErrUnableToFitDevice, model.FormFactor)
+}
 
-	if start < 0 {
-		return fmt.Errorf("%w: could not find a valid range of RU(s) to fit a device of size %d", ErrUnableToFitDevice, device.Model.FormFactor)
+// RackDevice attempts to put the passed device into the Rack and returns an error if there is no space
+// available for the device outside the RU(s) reserved for other categories, if the device overloads the Rack, or if
+// the device pushes a feed of the Rack over its budget and the Rack rejects such devices.
+func (r *Rack) RackDevice(device *Device) error {
+	el, side, ok := r.findFit(device.Model, device.Categories, nil)
+	if !ok {
+		return r.fitError(device.Model, device.Categories, 0, nil)
 	}
+	if err := r.enforcePower(device); err != nil {
+		return err
+	}
+	r.rackDevice(device, el, side)
+	return nil
+}
 
-	device.Elevation = start + 1
-	end := start - device.Model.FormFactor
-	for i := start; i > end; i-- {
-		r.Devices[i] = device
+// RackDeviceAt is like RackDevice but returns an error if the device cannot be racked at the elevation passed. a half
+// width device is racked in its Side, or in the first free side if it has none.
+func (r *Rack) RackDeviceAt(device *Device, el int) error {
+	side, ok := r.freeSide(device.Model, el, device.Side, nil)
+	if !ok {
+		if device.Side != "" {
+			return fmt.Errorf("%w: cannot fit a device of size %d at elevation %d, %s side", ErrUnableToFitDevice, device.Model.FormFactor, el, device.Side)
+		}
+		return fmt.Errorf("%w: cannot fit a device of size %d at elevation %d", ErrUnableToFitDevice, device.Model.FormFactor, el)
+	}
+	if err := r.checkLoad(device.Model, el, nil); err != nil {
+		return err
+	}
+	if err := r.enforcePower(device); err != nil {
+		return err
 	}
+	r.rackDevice(device, el, side)
 	return nil
 }
+
+var ErrUnableToFitDevice = errors.New("unable to fit device")
+
+// UnrackDevice empties the RU(s) of the device with the passed id and returns the device and true, or nil and false if
+// the device is not racked in the Rack.
+func (r *Rack) UnrackDevice(deviceId string) (*Device, bool) {
+	device, ok := r.GetDevice(deviceId)
+	if !ok {
+		return nil, false
+	}
+	for ru := range r.Units {
+		for slot, racked := range r.Units[ru] {
+			if racked == device {
+				r.Units[ru][slot] = nil
+			}
+		}
+	}
+	return device, true
+}
+
+// rackDevice fills the slots of the RU(s) of the device racked at elevation el in the side. the caller must check that
+// the device fits.
+func (r *Rack) rackDevice(device *Device, el int, side Side) {
+	device.Elevation = el
+	device.Side = side
+	for ru := el; ru > el-device.Model.FormFactor; ru-- {
+		for _, slot := range slots(device.Model.Mounting, side) {
+			r.Units[ru-1][slot] = device
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package datacenter
+
+import "github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+
+// Side is the half of the width of a rack a half width device is racked in. a full width device has no Side.
+type Side string
+
+const (
+	LeftSide  Side = "left"
+	RightSide Side = "right"
+)
+
+// the slots of an RU, a slot per face of the rack and half of its width.
+const (
+	frontLeftSlot = iota
+	frontRightSlot
+	rearLeftSlot
+	rearRightSlot
+	slotsPerRU
+)
+
+// RackUnit is an RU of a rack, every slot holds the device that occupies it.
+type RackUnit [slotsPerRU]*Device
+
+// IsEmpty returns true if no device occupies the RU.
+func (u RackUnit) IsEmpty() bool {
+	for _, device := range u {
+		if device != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// sides returns the sides a device of the mounting can be racked in, in the order they are tried. a full width device
+// is racked in the whole width.
+func sides(m hardware.Mounting) []Side {
+	if m.IsHalfWidth() {
+		return []Side{LeftSide, RightSide}
+	}
+	return []Side{""}
+}
+
+// slots returns the slots of an RU a device of the mounting racked in the side occupies. a full depth device occupies
+// both faces and a full width device both sides.
+func slots(m hardware.Mounting, side Side) []int {
+	faces := []int{frontLeftSlot, rearLeftSlot}
+	if m.IsHalfDepth() {
+		if m.MountedFace() == hardware.RearFace {
+			faces = []int{rearLeftSlot}
+		} else {
+			faces = []int{frontLeftSlot}
+		}
+	}
+
+	halves := []int{0, 1}
+	if m.IsHalfWidth() {
+		if side == RightSide {
+			halves = []int{1}
+		} else {
+			halves = []int{0}
+		}
+	}
+
+	occupied := make([]int, 0, len(faces)*len(halves))
+	for _, face := range faces {
+		for _, half := range halves {
+			occupied = append(occupied, face+half)
+		}
+	}
+	return occupied
+}
@@ -0,0 +1,143 @@
+package datacenter
+
+import (
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+)
+
+// newMountedDevice returns a 1U device of the mounting.
+func newMountedDevice(id string, mounting hardware.Mounting) *Device {
+	device := NewDevice()
+	device.ID = id
+	device.Model = hardware.HardwareModel{ID: id, FormFactor: 1, Mounting: mounting}
+	return device
+}
+
+// newSmallRack returns an empty rack of the size.
+func newSmallRack(size int) *Rack {
+	rack := NewRack()
+	rack.Name = "a01"
+	rack.SetSize(size)
+	return rack
+}
+
+var (
+	halfWidth = hardware.Mounting{Width: hardware.HalfWidth}
+	frontHalf = hardware.Mounting{Face: hardware.FrontFace, Depth: hardware.HalfDepth}
+	rearHalf  = hardware.Mounting{Face: hardware.RearFace, Depth: hardware.HalfDepth}
+	fullDepth = hardware.Mounting{}
+)
+
+func TestHalfWidthDevicesShareRU(t *testing.T) {
+	rack := newSmallRack(2)
+
+	left := newMountedDevice("hw1", halfWidth)
+	if err := rack.RackDeviceAt(left, 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	right := newMountedDevice("hw2", halfWidth)
+	if el, ok := rack.CanFitDevice(right.Model, nil); !ok || el != 2 {
+		t.Fatalf("expected the second half width device to fit at 2, got %d, %v", el, ok)
+	}
+	if err := rack.RackDeviceAt(right, 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if left.Side != LeftSide || right.Side != RightSide {
+		t.Fatalf("expected the devices on the left and the right, got %s and %s", left.Side, right.Side)
+	}
+
+	// the RU is full, whatever the width of the next device.
+	if rack.CanFitDeviceAt(newMountedDevice("hw3", halfWidth).Model, 2) {
+		t.Fatalf("expected a third half width device not to fit at 2")
+	}
+	if rack.CanFitDeviceAt(newMountedDevice("srv1", fullDepth).Model, 2) {
+		t.Fatalf("expected a full width device not to fit at 2")
+	}
+	if el, ok := rack.CanFitDevice(newMountedDevice("hw3", halfWidth).Model, nil); !ok || el != 1 {
+		t.Fatalf("expected a third half width device to fit at 1, got %d, %v", el, ok)
+	}
+
+	// a device racked in its side is racked in it again, e.g. when its event is replayed.
+	replayed := newSmallRack(2)
+	again := newMountedDevice("hw2", halfWidth)
+	again.Side = RightSide
+	if err := replayed.RackDeviceAt(again, 2); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if replayed.Units[1][frontRightSlot] != again || replayed.Units[1][frontLeftSlot] != nil {
+		t.Fatalf("expected the device to occupy the right side of 2, got %v", replayed.Units[1])
+	}
+}
+
+func TestRearDeviceBehindFrontDevice(t *testing.T) {
+	rack := newSmallRack(1)
+
+	front := newMountedDevice("pp1", frontHalf)
+	if err := rack.RackDeviceAt(front, 1); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	pdu := newMountedDevice("pdu1", rearHalf)
+	if !rack.CanFitDeviceAt(pdu.Model, 1) {
+		t.Fatalf("expected the PDU to fit behind the patch panel")
+	}
+	if err := rack.RackDeviceAt(pdu, 1); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+
+	unit := rack.Units[0]
+	if unit[frontLeftSlot] != front || unit[frontRightSlot] != front || unit[rearLeftSlot] != pdu || unit[rearRightSlot] != pdu {
+		t.Fatalf("expected the patch panel on the front and the PDU on the rear, got %v", unit)
+	}
+	if rack.CanFitDeviceAt(newMountedDevice("pp2", frontHalf).Model, 1) {
+		t.Fatalf("expected the front of the RU to be occupied")
+	}
+
+	// the RU is empty once both are unracked.
+	rack.UnrackDevice("pp1")
+	rack.UnrackDevice("pdu1")
+	if !rack.Units[0].IsEmpty() {
+		t.Fatalf("expected the RU to be empty, got %v", rack.Units[0])
+	}
+}
+
+func TestFullDepthDeviceBlocksBothFaces(t *testing.T) {
+	tests := []struct {
+		name     string
+		mounting hardware.Mounting
+	}{
+		{name: "front", mounting: frontHalf},
+		{name: "rear", mounting: rearHalf},
+		{name: "half width", mounting: halfWidth},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rack := newSmallRack(2)
+			// a 2U server occupies both faces of 2 and 1.
+			srv := newMountedDevice("srv1", fullDepth)
+			srv.Model.FormFactor = 2
+			if err := rack.RackDeviceAt(srv, 2); err != nil {
+				t.Fatalf("RackDeviceAt: %v", err)
+			}
+
+			model := newMountedDevice("d1", test.mounting).Model
+			for el := 1; el <= 2; el++ {
+				if rack.CanFitDeviceAt(model, el) {
+					t.Fatalf("expected the device not to fit at %d", el)
+				}
+			}
+			if _, ok := rack.CanFitDevice(model, nil); ok {
+				t.Fatalf("expected the device not to fit in the rack")
+			}
+		})
+	}
+
+	// and can't be racked where either face is occupied.
+	rack := newSmallRack(1)
+	if err := rack.RackDeviceAt(newMountedDevice("pdu1", rearHalf), 1); err != nil {
+		t.Fatalf("RackDeviceAt: %v", err)
+	}
+	if rack.CanFitDeviceAt(newMountedDevice("srv1", fullDepth).Model, 1) {
+		t.Fatalf("expected the server not to fit in front of the PDU")
+	}
+}
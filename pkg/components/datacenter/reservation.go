@@ -0,0 +1,93 @@
+package datacenter
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/ranges"
+)
+
+var ErrRUsReserved = errors.New("RU(s) reserved")
+
+// Reservation is a range of RU(s) of a rack set aside, e.g. for patch panels or PDUs. devices are never racked in
+// reserved RU(s) automatically unless they fall under one of the categories of the Reservation.
+type Reservation struct {
+	// the reserved RU(s), e.g. '42-45'.
+	RUs ranges.Range
+	// why the RU(s) are reserved.
+	Reason string
+	// the categories of the devices that may be racked in the reserved RU(s). (none if empty)
+	Categories []string
+}
+
+// Allows returns true if a device that falls under the categories may be racked in the reserved RU(s). categories are
+// compared case-insensitively, reservations recorded before their categories were lowercased keep their case.
+func (r Reservation) Allows(categories []string) bool {
+	for _, allowed := range r.Categories {
+		for _, category := range categories {
+			if strings.EqualFold(category, allowed) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Reserved returns the Reservation of the RU, and true if the RU is reserved.
+func (r *Rack) Reserved(ru int) (Reservation, bool) {
+	for _, reservation := range r.Reservations {
+		if reservation.RUs.InRange(ru) {
+			return reservation, true
+		}
+	}
+	return Reservation{}, false
+}
+
+// ReservedRUs returns the RU(s) of the Rack in the range that are already reserved, from the lowest to the highest.
+func (r *Rack) ReservedRUs(rus ranges.Range) []int {
+	reserved := make([]int, 0)
+	for ru := 1; ru <= len(r.Units); ru++ {
+		if _, ok := r.Reserved(ru); ok && rus.InRange(ru) {
+			reserved = append(reserved, ru)
+		}
+	}
+	return reserved
+}
+
+// Reserve reserves the RU(s) of the Reservation. the caller must check that they aren't already reserved.
+func (r *Rack) Reserve(reservation Reservation) {
+	r.Reservations = append(r.Reservations, reservation)
+}
+
+// HasReservation returns true if the RU(s) of the range were reserved with it.
+func (r *Rack) HasReservation(rus ranges.Range) bool {
+	for _, reservation := range r.Reservations {
+		if reservation.RUs.String() == rus.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Release releases the Reservation of the range of RU(s) and returns true, or false if the range isn't reserved. the
+// range must be the one the RU(s) were reserved with.
+func (r *Rack) Release(rus ranges.Range) bool {
+	for i, reservation := range r.Reservations {
+		if reservation.RUs.String() == rus.String() {
+			r.Reservations = append(r.Reservations[:i], r.Reservations[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// reservedFor returns the Reservation of the first RU of el through el-(formFactor-1) the categories aren't allowed in,
+// and true if there is one.
+func (r *Rack) reservedFor(categories []string, el, formFactor int) (Reservation, bool) {
+	for ru := el; ru > el-formFactor; ru-- {
+		if reservation, ok := r.Reserved(ru); ok && !reservation.Allows(categories) {
+			return reservation, true
+		}
+	}
+	return Reservation{}, false
+}
@@ -1,12 +1,45 @@
 package hardware
 
+import "github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+
 type HardwareModel struct {
 	// the unique identifier for the hardware model
 	ID string
 
 	PID        string
 	FormFactor int
-	Weight     float32
+	// how the hardware model is mounted in a rack.
+	Mounting Mounting
+	// the weight of the hardware model, in a unit of mass. (a zero Value is weightless)
+	Weight units.Value
+	// the power drawn by the hardware model.
+	Power PowerDraw
 
 	PortGroups []*PortGroup
 }
+
+// PowerDraw is the power drawn by a hardware model.
+type PowerDraw struct {
+	// the power drawn under a typical load, in watts.
+	Typical int
+	// the most power drawn, in watts. (a value of 0 is the typical draw)
+	Max int
+	// the number of power supplies, the draw is shared between the ones that are powered. (a value of 0 is 1)
+	PSUs int
+}
+
+// Peak returns the most power drawn, in watts.
+func (p PowerDraw) Peak() int {
+	if p.Max > 0 {
+		return p.Max
+	}
+	return p.Typical
+}
+
+// Supplies returns the number of power supplies.
+func (p PowerDraw) Supplies() int {
+	if p.PSUs > 0 {
+		return p.PSUs
+	}
+	return 1
+}
@@ -0,0 +1,102 @@
+package hardware
+
+import "strings"
+
+type Face string
+
+const (
+	UnknownFace Face = "unspecified"
+	FrontFace   Face = "front"
+	RearFace    Face = "rear"
+)
+
+// ParseFace parses the passed string into a Face.
+// UnknownFace is returned if the input doesn't match any valid Face values.
+func ParseFace(s string) Face {
+	switch strings.ToLower(s) {
+	case "front", "f":
+		return FrontFace
+	case "rear", "back", "r":
+		return RearFace
+	}
+
+	// unrecognized input
+	return UnknownFace
+}
+
+type Depth string
+
+const (
+	UnknownDepth Depth = "unspecified"
+	// FullDepth occupies the RU(s) it is racked in from the front of the rack to its rear.
+	FullDepth Depth = "full"
+	// HalfDepth only occupies the face of the RU(s) it is mounted on, another half depth device can be mounted on the
+	// other face.
+	HalfDepth Depth = "half"
+)
+
+// ParseDepth parses the passed string into a Depth.
+// UnknownDepth is returned if the input doesn't match any valid Depth values.
+func ParseDepth(s string) Depth {
+	switch strings.ToLower(s) {
+	case "full":
+		return FullDepth
+	case "half", "short":
+		return HalfDepth
+	}
+
+	// unrecognized input
+	return UnknownDepth
+}
+
+type Width string
+
+const (
+	UnknownWidth Width = "unspecified"
+	// FullWidth occupies the RU(s) it is racked in from one side of the rack to the other.
+	FullWidth Width = "full"
+	// HalfWidth occupies the left or the right half of the RU(s) it is racked in, two half width devices share them.
+	HalfWidth Width = "half"
+)
+
+// ParseWidth parses the passed string into a Width.
+// UnknownWidth is returned if the input doesn't match any valid Width values.
+func ParseWidth(s string) Width {
+	switch strings.ToLower(s) {
+	case "full":
+		return FullWidth
+	case "half":
+		return HalfWidth
+	}
+
+	// unrecognized input
+	return UnknownWidth
+}
+
+// Mounting is how a hardware model is mounted in a rack. the zero Mounting is a full depth, full width model mounted on
+// the front of the rack.
+type Mounting struct {
+	// the face of the rack the model is mounted on.
+	Face Face
+	// the depth and the width class of the model.
+	Depth Depth
+	Width Width
+}
+
+// MountedFace returns the face of the rack the model is mounted on.
+func (m Mounting) MountedFace() Face {
+	if m.Face == RearFace {
+		return RearFace
+	}
+	return FrontFace
+}
+
+// IsHalfDepth returns true if the model only occupies the face it is mounted on.
+func (m Mounting) IsHalfDepth() bool {
+	return m.Depth == HalfDepth
+}
+
+// IsHalfWidth returns true if the model occupies half of the width of the rack.
+func (m Mounting) IsHalfWidth() bool {
+	return m.Width == HalfWidth
+}
@@ -9,7 +9,7 @@ type BaseProjection struct {
 	// when the projection was updated
 	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt,omitempty"`
 	// when the projection was deleted
-	DeletedAt time.Time `json:"deletedAt" bson:"deltedAt,omitempty"`
+	DeletedAt time.Time `json:"deletedAt" bson:"deletedAt,omitempty"`
 }
 
 type projectionOptions func(*BaseProjection)
@@ -7,11 +7,12 @@ type DeviceProjection struct {
 
 	ID          string   `json:"id,omitempty" bson:"id,omitempty"`
 	Hostname    string   `json:"hostname,omitempty" bson:"hostname,omitempty"`
-	Elevation   int      `json:"elevation,omitempty" bson:"elevation,omitmpty"`
+	Elevation   int      `json:"elevation,omitempty" bson:"elevation,omitempty"`
 	Designation string   `json:"designation,omitempty" bson:"designation,omitempty"`
-	Cluster     int      `json:"cluster,omitmepty" bson:"cluster,omitempty"`
+	Cluster     int      `json:"cluster,omitempty" bson:"cluster,omitempty"`
 	Instance    int      `json:"instance,omitempty" bson:"instance,omitempty"`
 	Categories  []string `json:"categories,omitempty" bson:"categories,omitempty"`
+	ModelId     string   `json:"modelId,omitempty" bson:"modelId,omitempty"`
 
 	PodId        string `json:"podId,omitempty" bson:"podId,omitempty"`
 	RackId       string `json:"rackId,omitempty" bson:"rackId,omitempty"`
@@ -35,16 +36,18 @@ func projectionFromDevice(d *datacenter.Device, base BaseProjection) *DeviceProj
 	}
 
 	return &DeviceProjection{
-		ID:           d.ID,
-		Hostname:     d.Hostname,
-		Elevation:    d.Elevation,
-		Designation:  string(d.Designation),
-		Cluster:      d.Cluster,
-		Instance:     d.Instance,
-		Categories:   d.Categories,
-		PodId:        podId,
-		RackId:       rackId,
-		DatacenterId: datacenterId,
+		BaseProjection: base,
+		ID:             d.ID,
+		Hostname:       d.Hostname,
+		Elevation:      d.Elevation,
+		Designation:    string(d.Designation),
+		Cluster:        d.Cluster,
+		Instance:       d.Instance,
+		Categories:     d.Categories,
+		ModelId:        d.Model.ID,
+		PodId:          podId,
+		RackId:         rackId,
+		DatacenterId:   datacenterId,
 	}
 }
 
@@ -1,6 +1,9 @@
 package projections
 
-import "github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+import (
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
 
 type DeviceTemplateProjection struct {
 	BaseProjection `bson:",inline"`
@@ -12,7 +15,17 @@ type DeviceTemplateProjection struct {
 	HostnameTemplate string   `json:"hostnameTemplate,omitempty" bson:"hostnameTemplate,omitempty"`
 	Alias            string   `json:"alias,omitempty" bson:"alias,omitempty"`
 
-	ModelId string `json:"modelId,omitempty" bson:"modelId,omitempty"`
+	ModelId      string      `json:"modelId,omitempty" bson:"modelId,omitempty"`
+	FormFactor   int         `json:"formFactor,omitempty" bson:"formFactor,omitempty"`
+	Face         string      `json:"face,omitempty" bson:"face,omitempty"`
+	Depth        string      `json:"depth,omitempty" bson:"depth,omitempty"`
+	Width        string      `json:"width,omitempty" bson:"width,omitempty"`
+	Weight       units.Value `json:"weight,omitempty" bson:"weight,omitempty"`
+	TypicalPower int         `json:"typicalPower,omitempty" bson:"typicalPower,omitempty"`
+	MaxPower     int         `json:"maxPower,omitempty" bson:"maxPower,omitempty"`
+	PSUs         int         `json:"psus,omitempty" bson:"psus,omitempty"`
+
+	Placement string `json:"placement,omitempty" bson:"placement,omitempty"`
 }
 
 func projectionFromDeviceTemplate(dt *datacenter.DeviceTemplate, base BaseProjection) *DeviceTemplateProjection {
@@ -25,6 +38,15 @@ func projectionFromDeviceTemplate(dt *datacenter.DeviceTemplate, base BaseProjec
 		HostnameTemplate: dt.HostnameTemplate,
 		Alias:            dt.Alias,
 		ModelId:          dt.Model.ID,
+		FormFactor:       dt.Model.FormFactor,
+		Face:             string(dt.Model.Mounting.Face),
+		Depth:            string(dt.Model.Mounting.Depth),
+		Width:            string(dt.Model.Mounting.Width),
+		Weight:           dt.Model.Weight,
+		TypicalPower:     dt.Model.Power.Typical,
+		MaxPower:         dt.Model.Power.Max,
+		PSUs:             dt.Model.Power.PSUs,
+		Placement:        string(dt.Placement),
 	}
 }
 
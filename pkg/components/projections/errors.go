@@ -0,0 +1,8 @@
+package projections
+
+import "errors"
+
+var (
+	ErrProjectionNotFound  = errors.New("projection not found")
+	ErrDuplicateProjection = errors.New("duplicate projection")
+)
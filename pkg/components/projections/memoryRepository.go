@@ -0,0 +1,347 @@
+package projections
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// memoryCollection is a thread-safe, in-process collection of projections indexed by id. it mirrors the behaviour of
+// the MongoDB repositories so it can stand in for them in tests.
+type memoryCollection[T any] struct {
+	mu    sync.RWMutex
+	items map[string]T
+	// base returns the BaseProjection of an item so the collection can soft-delete it.
+	base func(item *T) *BaseProjection
+	// conflicts reports whether two items with different ids violate a unique index of the collection.
+	conflicts func(a, b T) bool
+}
+
+func newMemoryCollection[T any](base func(item *T) *BaseProjection, conflicts func(a, b T) bool) *memoryCollection[T] {
+	return &memoryCollection[T]{
+		items:     make(map[string]T),
+		base:      base,
+		conflicts: conflicts,
+	}
+}
+
+func (c *memoryCollection[T]) upsert(id string, item T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// like the unique indexes of the MongoDB repositories, soft-deleted items never conflict.
+	if c.conflicts != nil && !c.deleted(&item) {
+		for existingId, existing := range c.items {
+			if existingId != id && !c.deleted(&existing) && c.conflicts(existing, item) {
+				return fmt.Errorf("%w: {%s} conflicts with {%s}", ErrDuplicateProjection, id, existingId)
+			}
+		}
+	}
+
+	c.items[id] = item
+	return nil
+}
+
+func (c *memoryCollection[T]) delete(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[id]
+	if !ok || c.deleted(&item) {
+		return fmt.Errorf("%w {%s}", ErrProjectionNotFound, id)
+	}
+
+	c.base(&item).DeletedAt = time.Now()
+	c.items[id] = item
+	return nil
+}
+
+func (c *memoryCollection[T]) get(id string) (*T, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	item, ok := c.items[id]
+	if !ok || c.deleted(&item) {
+		return nil, fmt.Errorf("%w {%s}", ErrProjectionNotFound, id)
+	}
+	return &item, nil
+}
+
+// find returns the first item, ordered by id, that matches the filter.
+func (c *memoryCollection[T]) find(filter func(item T) bool) (*T, error) {
+	items := c.list(filter)
+	if len(items) == 0 {
+		return nil, ErrProjectionNotFound
+	}
+	return items[0], nil
+}
+
+// list returns every item that matches the filter, ordered by id.
+func (c *memoryCollection[T]) list(filter func(item T) bool) []*T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids := make([]string, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]*T, 0)
+	for _, id := range ids {
+		item := c.items[id]
+		if !c.deleted(&item) && filter(item) {
+			items = append(items, &item)
+		}
+	}
+	return items
+}
+
+func (c *memoryCollection[T]) deleteAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[string]T)
+}
+
+func (c *memoryCollection[T]) deleted(item *T) bool {
+	return !c.base(item).DeletedAt.IsZero()
+}
+
+func all[T any](T) bool {
+	return true
+}
+
+func hasCategory(categories []string, category string) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// memoryDatacenterRepository is an in-process implementation of DatacenterRepository.
+type memoryDatacenterRepository struct {
+	collection *memoryCollection[DatacenterProjection]
+}
+
+func NewMemoryDatacenterRepository() *memoryDatacenterRepository {
+	return &memoryDatacenterRepository{
+		collection: newMemoryCollection(
+			func(p *DatacenterProjection) *BaseProjection { return &p.BaseProjection },
+			func(a, b DatacenterProjection) bool { return a.Site == b.Site },
+		),
+	}
+}
+
+func (r *memoryDatacenterRepository) Upsert(ctx context.Context, projection *DatacenterProjection) error {
+	return r.collection.upsert(projection.ID, *projection)
+}
+
+func (r *memoryDatacenterRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(id)
+}
+
+func (r *memoryDatacenterRepository) GetById(ctx context.Context, id string) (*DatacenterProjection, error) {
+	return r.collection.get(id)
+}
+
+func (r *memoryDatacenterRepository) GetBySite(ctx context.Context, site string) (*DatacenterProjection, error) {
+	projection, err := r.collection.find(func(p DatacenterProjection) bool { return p.Site == site })
+	if err != nil {
+		return nil, fmt.Errorf("%w {site: %s}", err, site)
+	}
+	return projection, nil
+}
+
+func (r *memoryDatacenterRepository) List(ctx context.Context) ([]*DatacenterProjection, error) {
+	return r.collection.list(all[DatacenterProjection]), nil
+}
+
+func (r *memoryDatacenterRepository) DeleteAll(ctx context.Context) error {
+	r.collection.deleteAll()
+	return nil
+}
+
+// memoryRackRepository is an in-process implementation of RackRepository.
+type memoryRackRepository struct {
+	collection *memoryCollection[RackProjection]
+}
+
+func NewMemoryRackRepository() *memoryRackRepository {
+	return &memoryRackRepository{
+		collection: newMemoryCollection(
+			func(p *RackProjection) *BaseProjection { return &p.BaseProjection },
+			func(a, b RackProjection) bool { return a.DatacenterId == b.DatacenterId && a.Name == b.Name },
+		),
+	}
+}
+
+func (r *memoryRackRepository) Upsert(ctx context.Context, projection *RackProjection) error {
+	return r.collection.upsert(projection.ID, *projection)
+}
+
+func (r *memoryRackRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(id)
+}
+
+func (r *memoryRackRepository) GetById(ctx context.Context, id string) (*RackProjection, error) {
+	return r.collection.get(id)
+}
+
+func (r *memoryRackRepository) GetByName(ctx context.Context, datacenterId, name string) (*RackProjection, error) {
+	projection, err := r.collection.find(func(p RackProjection) bool { return p.DatacenterId == datacenterId && p.Name == name })
+	if err != nil {
+		return nil, fmt.Errorf("%w {datacenterId: %s, name: %s}", err, datacenterId, name)
+	}
+	return projection, nil
+}
+
+func (r *memoryRackRepository) ListByDatacenter(ctx context.Context, datacenterId string) ([]*RackProjection, error) {
+	return r.collection.list(func(p RackProjection) bool { return p.DatacenterId == datacenterId }), nil
+}
+
+func (r *memoryRackRepository) DeleteAll(ctx context.Context) error {
+	r.collection.deleteAll()
+	return nil
+}
+
+// memoryPodRepository is an in-process implementation of PodRepository.
+type memoryPodRepository struct {
+	collection *memoryCollection[PodProjection]
+}
+
+func NewMemoryPodRepository() *memoryPodRepository {
+	return &memoryPodRepository{
+		collection: newMemoryCollection(
+			func(p *PodProjection) *BaseProjection { return &p.BaseProjection },
+			func(a, b PodProjection) bool { return a.DatacenterId == b.DatacenterId && a.Name == b.Name },
+		),
+	}
+}
+
+func (r *memoryPodRepository) Upsert(ctx context.Context, projection *PodProjection) error {
+	return r.collection.upsert(projection.ID, *projection)
+}
+
+func (r *memoryPodRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(id)
+}
+
+func (r *memoryPodRepository) GetById(ctx context.Context, id string) (*PodProjection, error) {
+	return r.collection.get(id)
+}
+
+func (r *memoryPodRepository) GetByName(ctx context.Context, datacenterId, name string) (*PodProjection, error) {
+	projection, err := r.collection.find(func(p PodProjection) bool { return p.DatacenterId == datacenterId && p.Name == name })
+	if err != nil {
+		return nil, fmt.Errorf("%w {datacenterId: %s, name: %s}", err, datacenterId, name)
+	}
+	return projection, nil
+}
+
+func (r *memoryPodRepository) ListByDatacenter(ctx context.Context, datacenterId string) ([]*PodProjection, error) {
+	return r.collection.list(func(p PodProjection) bool { return p.DatacenterId == datacenterId }), nil
+}
+
+func (r *memoryPodRepository) DeleteAll(ctx context.Context) error {
+	r.collection.deleteAll()
+	return nil
+}
+
+// memoryDeviceRepository is an in-process implementation of DeviceRepository.
+type memoryDeviceRepository struct {
+	collection *memoryCollection[DeviceProjection]
+}
+
+func NewMemoryDeviceRepository() *memoryDeviceRepository {
+	return &memoryDeviceRepository{
+		collection: newMemoryCollection(
+			func(p *DeviceProjection) *BaseProjection { return &p.BaseProjection },
+			nil,
+		),
+	}
+}
+
+func (r *memoryDeviceRepository) Upsert(ctx context.Context, projection *DeviceProjection) error {
+	return r.collection.upsert(projection.ID, *projection)
+}
+
+func (r *memoryDeviceRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(id)
+}
+
+func (r *memoryDeviceRepository) GetById(ctx context.Context, id string) (*DeviceProjection, error) {
+	return r.collection.get(id)
+}
+
+func (r *memoryDeviceRepository) GetByHostname(ctx context.Context, hostname string) (*DeviceProjection, error) {
+	projection, err := r.collection.find(func(p DeviceProjection) bool { return p.Hostname == hostname })
+	if err != nil {
+		return nil, fmt.Errorf("%w {hostname: %s}", err, hostname)
+	}
+	return projection, nil
+}
+
+func (r *memoryDeviceRepository) ListByRack(ctx context.Context, rackId string) ([]*DeviceProjection, error) {
+	projections := r.collection.list(func(p DeviceProjection) bool { return p.RackId == rackId })
+	sort.SliceStable(projections, func(i, j int) bool {
+		return projections[i].Elevation < projections[j].Elevation
+	})
+	return projections, nil
+}
+
+func (r *memoryDeviceRepository) ListByPod(ctx context.Context, podId string) ([]*DeviceProjection, error) {
+	return r.collection.list(func(p DeviceProjection) bool { return p.PodId == podId }), nil
+}
+
+func (r *memoryDeviceRepository) ListByCategory(ctx context.Context, category string) ([]*DeviceProjection, error) {
+	return r.collection.list(func(p DeviceProjection) bool { return hasCategory(p.Categories, category) }), nil
+}
+
+func (r *memoryDeviceRepository) DeleteAll(ctx context.Context) error {
+	r.collection.deleteAll()
+	return nil
+}
+
+// memoryDeviceTemplateRepository is an in-process implementation of DeviceTemplateRepository.
+type memoryDeviceTemplateRepository struct {
+	collection *memoryCollection[DeviceTemplateProjection]
+}
+
+func NewMemoryDeviceTemplateRepository() *memoryDeviceTemplateRepository {
+	return &memoryDeviceTemplateRepository{
+		collection: newMemoryCollection(
+			func(p *DeviceTemplateProjection) *BaseProjection { return &p.BaseProjection },
+			nil,
+		),
+	}
+}
+
+func (r *memoryDeviceTemplateRepository) Upsert(ctx context.Context, projection *DeviceTemplateProjection) error {
+	return r.collection.upsert(projection.ID, *projection)
+}
+
+func (r *memoryDeviceTemplateRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(id)
+}
+
+func (r *memoryDeviceTemplateRepository) GetById(ctx context.Context, id string) (*DeviceTemplateProjection, error) {
+	return r.collection.get(id)
+}
+
+func (r *memoryDeviceTemplateRepository) ListByCategory(ctx context.Context, category string) ([]*DeviceTemplateProjection, error) {
+	return r.collection.list(func(p DeviceTemplateProjection) bool { return hasCategory(p.Categories, category) }), nil
+}
+
+func (r *memoryDeviceTemplateRepository) List(ctx context.Context) ([]*DeviceTemplateProjection, error) {
+	return r.collection.list(all[DeviceTemplateProjection]), nil
+}
+
+func (r *memoryDeviceTemplateRepository) DeleteAll(ctx context.Context) error {
+	r.collection.deleteAll()
+	return nil
+}
@@ -0,0 +1,168 @@
+package projections
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryRackRepositoryUniqueName(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryRackRepository()
+
+	if err := repo.Upsert(ctx, &RackProjection{ID: "r1", Name: "a01", DatacenterId: "dc1"}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	// the same name is allowed in another datacenter, and the projection can be replaced.
+	for _, rack := range []*RackProjection{
+		{ID: "r2", Name: "a01", DatacenterId: "dc2"},
+		{ID: "r1", Name: "a01", DatacenterId: "dc1", Size: 48},
+	} {
+		if err := repo.Upsert(ctx, rack); err != nil {
+			t.Fatalf("Upsert {%s}: %v", rack.ID, err)
+		}
+	}
+	if err := repo.Upsert(ctx, &RackProjection{ID: "r3", Name: "a01", DatacenterId: "dc1"}); !errors.Is(err, ErrDuplicateProjection) {
+		t.Fatalf("expected %v, got %v", ErrDuplicateProjection, err)
+	}
+
+	// a soft-deleted rack no longer holds its name.
+	if err := repo.Delete(ctx, "r1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := repo.Upsert(ctx, &RackProjection{ID: "r3", Name: "a01", DatacenterId: "dc1"}); err != nil {
+		t.Fatalf("Upsert after Delete: %v", err)
+	}
+
+	rack, err := repo.GetByName(ctx, "dc1", "a01")
+	if err != nil {
+		t.Fatalf("GetByName: %v", err)
+	}
+	if rack.ID != "r3" {
+		t.Fatalf("expected {r3}, got {%s}", rack.ID)
+	}
+}
+
+func TestMemoryDatacenterRepositoryUniqueSite(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryDatacenterRepository()
+
+	if err := repo.Upsert(ctx, &DatacenterProjection{ID: "dc1", Site: "dal1"}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	if err := repo.Upsert(ctx, &DatacenterProjection{ID: "dc2", Site: "dal1"}); !errors.Is(err, ErrDuplicateProjection) {
+		t.Fatalf("expected %v, got %v", ErrDuplicateProjection, err)
+	}
+
+	if err := repo.Delete(ctx, "dc1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := repo.Upsert(ctx, &DatacenterProjection{ID: "dc2", Site: "dal1"}); err != nil {
+		t.Fatalf("Upsert after Delete: %v", err)
+	}
+	// the deleted datacenter can't take its site back while another datacenter holds it.
+	if err := repo.Upsert(ctx, &DatacenterProjection{ID: "dc1", Site: "dal1"}); !errors.Is(err, ErrDuplicateProjection) {
+		t.Fatalf("expected %v, got %v", ErrDuplicateProjection, err)
+	}
+}
+
+func TestMemoryPodRepositorySoftDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryPodRepository()
+
+	for _, pod := range []*PodProjection{
+		{ID: "p1", Name: "compute1", DatacenterId: "dc1"},
+		{ID: "p2", Name: "compute2", DatacenterId: "dc1"},
+	} {
+		if err := repo.Upsert(ctx, pod); err != nil {
+			t.Fatalf("Upsert {%s}: %v", pod.ID, err)
+		}
+	}
+
+	if err := repo.Delete(ctx, "p1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := repo.Delete(ctx, "p1"); !errors.Is(err, ErrProjectionNotFound) {
+		t.Fatalf("expected a deleted pod to be deleted once, got %v", err)
+	}
+	if _, err := repo.GetById(ctx, "p1"); !errors.Is(err, ErrProjectionNotFound) {
+		t.Fatalf("expected %v, got %v", ErrProjectionNotFound, err)
+	}
+	if _, err := repo.GetByName(ctx, "dc1", "compute1"); !errors.Is(err, ErrProjectionNotFound) {
+		t.Fatalf("expected %v, got %v", ErrProjectionNotFound, err)
+	}
+
+	pods, err := repo.ListByDatacenter(ctx, "dc1")
+	if err != nil {
+		t.Fatalf("ListByDatacenter: %v", err)
+	}
+	if len(pods) != 1 || pods[0].ID != "p2" {
+		t.Fatalf("expected only {p2}, got %v", pods)
+	}
+}
+
+func TestMemoryDeviceRepositoryQueries(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryDeviceRepository()
+
+	for _, device := range []*DeviceProjection{
+		{ID: "d1", Hostname: "sw1", RackId: "r1", Elevation: 40, PodId: "p1", Categories: []string{"network"}},
+		{ID: "d2", Hostname: "srv1", RackId: "r1", Elevation: 2, PodId: "p1", Categories: []string{"compute"}},
+		{ID: "d3", Hostname: "srv2", RackId: "r2", Elevation: 2, PodId: "p2", Categories: []string{"compute"}},
+	} {
+		if err := repo.Upsert(ctx, device); err != nil {
+			t.Fatalf("Upsert {%s}: %v", device.ID, err)
+		}
+	}
+
+	device, err := repo.GetByHostname(ctx, "srv2")
+	if err != nil || device.ID != "d3" {
+		t.Fatalf("GetByHostname: expected {d3}, got %v, %v", device, err)
+	}
+
+	racked, err := repo.ListByRack(ctx, "r1")
+	if err != nil {
+		t.Fatalf("ListByRack: %v", err)
+	}
+	if len(racked) != 2 || racked[0].ID != "d2" || racked[1].ID != "d1" {
+		t.Fatalf("expected {d2} and {d1} ordered by elevation, got %v", racked)
+	}
+
+	if err = repo.Delete(ctx, "d3"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	compute, err := repo.ListByCategory(ctx, "compute")
+	if err != nil {
+		t.Fatalf("ListByCategory: %v", err)
+	}
+	if len(compute) != 1 || compute[0].ID != "d2" {
+		t.Fatalf("expected only {d2}, got %v", compute)
+	}
+	if pod, _ := repo.ListByPod(ctx, "p2"); len(pod) != 0 {
+		t.Fatalf("expected the deleted device to be hidden, got %v", pod)
+	}
+}
+
+func TestMemoryDeviceRepositorySoftDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryDeviceRepository()
+
+	if err := repo.Upsert(ctx, &DeviceProjection{ID: "d1", Hostname: "srv1", Instance: 1, DatacenterId: "dc1"}); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+	if err := repo.Delete(ctx, "d1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	// the projection is kept, only its DeletedAt is set.
+	deleted, ok := repo.collection.items["d1"]
+	if !ok {
+		t.Fatalf("expected the deleted device to be kept")
+	}
+	if deleted.DeletedAt.IsZero() || deleted.Hostname != "srv1" || deleted.Instance != 1 || deleted.DatacenterId != "dc1" {
+		t.Fatalf("expected srv1 to be soft-deleted, got %+v", deleted)
+	}
+	if _, err := repo.GetById(ctx, "d1"); !errors.Is(err, ErrProjectionNotFound) {
+		t.Fatalf("expected %v, got %v", ErrProjectionNotFound, err)
+	}
+}
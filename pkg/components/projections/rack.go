@@ -1,13 +1,85 @@
 package projections
 
-import "github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+import (
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
+)
 
 type RackProjection struct {
 	BaseProjection `bson:",inline"`
 
 	ID           string `json:"id,omitempty" bson:"id,omitempty"`
+	Name         string `json:"name,omitempty" bson:"name,omitempty"`
 	Size         int    `json:"size,omitempty" bson:"size,omitempty"`
 	DatacenterId string `json:"datacenterId,omitempty" bson:"datacenterId,omitempty"`
+
+	Feeds             []datacenter.PowerFeed `json:"feeds,omitempty" bson:"feeds,omitempty"`
+	DeratingThreshold float64                `json:"deratingThreshold,omitempty" bson:"deratingThreshold,omitempty"`
+	PowerPolicy       string                 `json:"powerPolicy,omitempty" bson:"powerPolicy,omitempty"`
+
+	StaticRating            units.Value `json:"staticRating,omitempty" bson:"staticRating,omitempty"`
+	DynamicRating           units.Value `json:"dynamicRating,omitempty" bson:"dynamicRating,omitempty"`
+	HeavyDeviceWeight       units.Value `json:"heavyDeviceWeight,omitempty" bson:"heavyDeviceWeight,omitempty"`
+	HeavyDeviceMaxElevation int         `json:"heavyDeviceMaxElevation,omitempty" bson:"heavyDeviceMaxElevation,omitempty"`
+
+	// the ranges of RU(s) of the rack devices are only racked in automatically if they fall under their categories.
+	Reservations []RackReservation `json:"reservations,omitempty" bson:"reservations,omitempty"`
+
+	// the weight of and the power drawn by the devices racked in the rack.
+	Devices []RackedDevice `json:"devices,omitempty" bson:"devices,omitempty"`
+}
+
+// RackReservation is a range of reserved RU(s) of a rack, see datacenter.Reservation.
+type RackReservation struct {
+	RUs        string   `json:"rus" bson:"rus"`
+	Reason     string   `json:"reason,omitempty" bson:"reason,omitempty"`
+	Categories []string `json:"categories,omitempty" bson:"categories,omitempty"`
+}
+
+// RackedDevice is the weight of and the power drawn by a device racked in a rack.
+type RackedDevice struct {
+	DeviceId     string      `json:"deviceId" bson:"deviceId"`
+	Weight       units.Value `json:"weight,omitempty" bson:"weight,omitempty"`
+	TypicalPower int         `json:"typicalPower,omitempty" bson:"typicalPower,omitempty"`
+	MaxPower     int         `json:"maxPower,omitempty" bson:"maxPower,omitempty"`
+	PSUs         int         `json:"psus,omitempty" bson:"psus,omitempty"`
+}
+
+// Power returns the power available to the rack.
+func (p *RackProjection) Power() datacenter.RackPower {
+	return datacenter.RackPower{
+		Feeds:             p.Feeds,
+		DeratingThreshold: p.DeratingThreshold,
+		Policy:            datacenter.PowerPolicy(p.PowerPolicy),
+	}
+}
+
+// Load returns the weight the rack is rated to bear.
+func (p *RackProjection) Load() datacenter.RackLoad {
+	return datacenter.RackLoad{
+		StaticRating:            p.StaticRating,
+		DynamicRating:           p.DynamicRating,
+		HeavyDeviceWeight:       p.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: p.HeavyDeviceMaxElevation,
+	}
+}
+
+// PowerReport reports the load of the devices racked in the rack on its feeds.
+func (p *RackProjection) PowerReport() datacenter.PowerReport {
+	return datacenter.NewPowerReport(p.Power(), p.rackedDevices())
+}
+
+func (p *RackProjection) rackedDevices() []*datacenter.Device {
+	devices := make([]*datacenter.Device, 0, len(p.Devices))
+	for _, racked := range p.Devices {
+		device := datacenter.NewDevice()
+		device.ID = racked.DeviceId
+		device.Model.Weight = racked.Weight
+		device.Model.Power = hardware.PowerDraw{Typical: racked.TypicalPower, Max: racked.MaxPower, PSUs: racked.PSUs}
+		devices = append(devices, device)
+	}
+	return devices
 }
 
 func projectionFromRack(r *datacenter.Rack, base BaseProjection) *RackProjection {
@@ -15,11 +87,43 @@ func projectionFromRack(r *datacenter.Rack, base BaseProjection) *RackProjection
 	if r.Datacenter != nil {
 		datacenterId = r.Datacenter.ID
 	}
+
+	devices := make([]RackedDevice, 0)
+	for _, device := range r.RackedDevices() {
+		devices = append(devices, RackedDevice{
+			DeviceId:     device.ID,
+			Weight:       device.Model.Weight,
+			TypicalPower: device.Model.Power.Typical,
+			MaxPower:     device.Model.Power.Max,
+			PSUs:         device.Model.Power.PSUs,
+		})
+	}
+
+	reservations := make([]RackReservation, 0, len(r.Reservations))
+	for _, reservation := range r.Reservations {
+		reservations = append(reservations, RackReservation{
+			RUs:        reservation.RUs.String(),
+			Reason:     reservation.Reason,
+			Categories: reservation.Categories,
+		})
+	}
+
 	return &RackProjection{
-		BaseProjection: base,
-		ID:             r.ID,
-		Size:           r.Size,
-		DatacenterId:   datacenterId,
+		BaseProjection:    base,
+		ID:                r.ID,
+		Name:              r.Name,
+		Size:              r.Size,
+		DatacenterId:      datacenterId,
+		Feeds:             r.Power.Feeds,
+		DeratingThreshold: r.Power.DeratingThreshold,
+		PowerPolicy:       string(r.Power.Policy),
+
+		StaticRating:            r.Load.StaticRating,
+		DynamicRating:           r.Load.DynamicRating,
+		HeavyDeviceWeight:       r.Load.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: r.Load.HeavyDeviceMaxElevation,
+		Reservations:            reservations,
+		Devices:                 devices,
 	}
 }
 
@@ -0,0 +1,95 @@
+package projections
+
+import "context"
+
+// DatacenterRepository persists DatacenterProjections.
+type DatacenterRepository interface {
+	// Upsert inserts the projection or replaces the projection with the same id. ErrDuplicateProjection is returned if
+	// another datacenter has the same site.
+	Upsert(ctx context.Context, projection *DatacenterProjection) error
+	// Delete soft-deletes the projection with the passed id by setting its DeletedAt, which hides it from every Get and
+	// List method. ErrProjectionNotFound is returned if it does not exist.
+	Delete(ctx context.Context, id string) error
+	// GetById returns the projection with the passed id. ErrProjectionNotFound is returned if it does not exist.
+	GetById(ctx context.Context, id string) (*DatacenterProjection, error)
+	// GetBySite returns the projection of the datacenter at the passed site.
+	GetBySite(ctx context.Context, site string) (*DatacenterProjection, error)
+	// List returns every projection.
+	List(ctx context.Context) ([]*DatacenterProjection, error)
+	// DeleteAll removes every projection from the repository.
+	DeleteAll(ctx context.Context) error
+}
+
+// RackRepository persists RackProjections.
+type RackRepository interface {
+	// Upsert inserts the projection or replaces the projection with the same id. ErrDuplicateProjection is returned if
+	// another rack in the datacenter has the same name.
+	Upsert(ctx context.Context, projection *RackProjection) error
+	// Delete soft-deletes the projection with the passed id by setting its DeletedAt, which hides it from every Get and
+	// List method. ErrProjectionNotFound is returned if it does not exist.
+	Delete(ctx context.Context, id string) error
+	// GetById returns the projection with the passed id. ErrProjectionNotFound is returned if it does not exist.
+	GetById(ctx context.Context, id string) (*RackProjection, error)
+	// GetByName returns the projection of the rack with the passed name in the datacenter.
+	GetByName(ctx context.Context, datacenterId, name string) (*RackProjection, error)
+	// ListByDatacenter returns the projections of every rack in the datacenter.
+	ListByDatacenter(ctx context.Context, datacenterId string) ([]*RackProjection, error)
+	// DeleteAll removes every projection from the repository.
+	DeleteAll(ctx context.Context) error
+}
+
+// PodRepository persists PodProjections.
+type PodRepository interface {
+	// Upsert inserts the projection or replaces the projection with the same id. ErrDuplicateProjection is returned if
+	// another pod in the datacenter has the same name.
+	Upsert(ctx context.Context, projection *PodProjection) error
+	// Delete soft-deletes the projection with the passed id by setting its DeletedAt, which hides it from every Get and
+	// List method. ErrProjectionNotFound is returned if it does not exist.
+	Delete(ctx context.Context, id string) error
+	// GetById returns the projection with the passed id. ErrProjectionNotFound is returned if it does not exist.
+	GetById(ctx context.Context, id string) (*PodProjection, error)
+	// GetByName returns the projection of the pod with the passed name in the datacenter.
+	GetByName(ctx context.Context, datacenterId, name string) (*PodProjection, error)
+	// ListByDatacenter returns the projections of every pod in the datacenter.
+	ListByDatacenter(ctx context.Context, datacenterId string) ([]*PodProjection, error)
+	// DeleteAll removes every projection from the repository.
+	DeleteAll(ctx context.Context) error
+}
+
+// DeviceRepository persists DeviceProjections.
+type DeviceRepository interface {
+	// Upsert inserts the projection or replaces the projection with the same id.
+	Upsert(ctx context.Context, projection *DeviceProjection) error
+	// Delete soft-deletes the projection with the passed id by setting its DeletedAt, which hides it from every Get and
+	// List method. ErrProjectionNotFound is returned if it does not exist.
+	Delete(ctx context.Context, id string) error
+	// GetById returns the projection with the passed id. ErrProjectionNotFound is returned if it does not exist.
+	GetById(ctx context.Context, id string) (*DeviceProjection, error)
+	// GetByHostname returns the projection of the device with the passed hostname.
+	GetByHostname(ctx context.Context, hostname string) (*DeviceProjection, error)
+	// ListByRack returns the projections of every device in the rack, ordered by elevation.
+	ListByRack(ctx context.Context, rackId string) ([]*DeviceProjection, error)
+	// ListByPod returns the projections of every device in the pod.
+	ListByPod(ctx context.Context, podId string) ([]*DeviceProjection, error)
+	// ListByCategory returns the projections of every device in the category.
+	ListByCategory(ctx context.Context, category string) ([]*DeviceProjection, error)
+	// DeleteAll removes every projection from the repository.
+	DeleteAll(ctx context.Context) error
+}
+
+// DeviceTemplateRepository persists DeviceTemplateProjections.
+type DeviceTemplateRepository interface {
+	// Upsert inserts the projection or replaces the projection with the same id.
+	Upsert(ctx context.Context, projection *DeviceTemplateProjection) error
+	// Delete soft-deletes the projection with the passed id by setting its DeletedAt, which hides it from every Get and
+	// List method. ErrProjectionNotFound is returned if it does not exist.
+	Delete(ctx context.Context, id string) error
+	// GetById returns the projection with the passed id. ErrProjectionNotFound is returned if it does not exist.
+	GetById(ctx context.Context, id string) (*DeviceTemplateProjection, error)
+	// ListByCategory returns the projections of every device template in the category.
+	ListByCategory(ctx context.Context, category string) ([]*DeviceTemplateProjection, error)
+	// List returns every projection.
+	List(ctx context.Context) ([]*DeviceTemplateProjection, error)
+	// DeleteAll removes every projection from the repository.
+	DeleteAll(ctx context.Context) error
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
 	"github.com/malijoe/DatacenterGenerator/pkg/internal/units"
 )
@@ -9,11 +10,22 @@ import (
 const (
 	DatacenterCreated     = "V1_DATACENTER_CREATED"
 	PodCreated            = "V1_POD_CREATED"
+	PodDeleted            = "V1_POD_DELETED"
 	DatacenterPodAdded    = "V1_DATACENTER_POD_ADDED"
 	RackCreated           = "V1_RACK_CREATED"
+	RackDeleted           = "V1_RACK_DELETED"
+	RackPowerConfigured   = "V1_RACK_POWER_CONFIGURED"
+	RackLoadConfigured    = "V1_RACK_LOAD_CONFIGURED"
+	RackRUsReserved       = "V1_RACK_RUS_RESERVED"
+	RackRUsReleased       = "V1_RACK_RUS_RELEASED"
 	DatacenterRackAdded   = "V1_DATACENTER_RACK_ADDED"
 	DeviceCreated         = "V1_DEVICE_CREATED"
+	DatacenterDeviceAdded = "V1_DATACENTER_DEVICE_ADDED"
 	DeviceRacked          = "V1_DEVICE_RACKED"
+	DeviceUnracked        = "V1_DEVICE_UNRACKED"
+	DeviceRemoved         = "V1_DEVICE_REMOVED"
+	DeviceMoved           = "V1_DEVICE_MOVED"
+	DeviceDecommissioned  = "V1_DEVICE_DECOMMISSIONED"
 	DeviceTemplateCreated = "V1_DEVICE_TEMPLATE_CREATED"
 )
 
@@ -40,8 +52,8 @@ func NewDatacenterCreatedEvent(aggregate events.Aggregate, site, building, room
 
 type PodCreatedEvent struct {
 	Function     datacenter.Function `json:"function"`
-	Instance     int
-	DatacenterId string `json:"datacenterId"`
+	Instance     int                 `json:"instance"`
+	DatacenterId string              `json:"datacenterId"`
 }
 
 func NewPodCreatedEvent(aggregate events.Aggregate, function datacenter.Function, instance int, datacenterId string) (events.Event, error) {
@@ -57,13 +69,31 @@ func NewPodCreatedEvent(aggregate events.Aggregate, function datacenter.Function
 	return event, nil
 }
 
+type PodDeletedEvent struct {
+	Reason string `json:"reason"`
+}
+
+func NewPodDeletedEvent(aggregate events.Aggregate, reason string) (events.Event, error) {
+	data := PodDeletedEvent{
+		Reason: reason,
+	}
+	event := events.NewBaseEvent(aggregate, PodDeleted)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
 type DatacenterPodAddedEvent struct {
 	PodId string `json:"podId"`
+	// the function of the pod, used to count the pod's instance. empty for pods added before it was recorded.
+	Function datacenter.Function `json:"function,omitempty"`
 }
 
-func NewDatacenterPodAddedEvent(aggregate events.Aggregate, podId string) (events.Event, error) {
+func NewDatacenterPodAddedEvent(aggregate events.Aggregate, podId string, function datacenter.Function) (events.Event, error) {
 	data := DatacenterPodAddedEvent{
-		PodId: podId,
+		PodId:    podId,
+		Function: function,
 	}
 	event := events.NewBaseEvent(aggregate, DatacenterPodAdded)
 	if err := event.SetJsonData(&data); err != nil {
@@ -91,6 +121,99 @@ func NewRackCreatedEvent(aggregate events.Aggregate, name string, size int, data
 	return event, nil
 }
 
+type RackDeletedEvent struct {
+	Reason string `json:"reason"`
+}
+
+func NewRackDeletedEvent(aggregate events.Aggregate, reason string) (events.Event, error) {
+	data := RackDeletedEvent{
+		Reason: reason,
+	}
+	event := events.NewBaseEvent(aggregate, RackDeleted)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type RackPowerConfiguredEvent struct {
+	Feeds             []datacenter.PowerFeed `json:"feeds"`
+	DeratingThreshold float64                `json:"deratingThreshold"`
+	Policy            datacenter.PowerPolicy `json:"policy"`
+}
+
+func NewRackPowerConfiguredEvent(aggregate events.Aggregate, feeds []datacenter.PowerFeed, deratingThreshold float64, policy datacenter.PowerPolicy) (events.Event, error) {
+	data := RackPowerConfiguredEvent{
+		Feeds:             feeds,
+		DeratingThreshold: deratingThreshold,
+		Policy:            policy,
+	}
+	event := events.NewBaseEvent(aggregate, RackPowerConfigured)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type RackLoadConfiguredEvent struct {
+	StaticRating            units.Value `json:"staticRating"`
+	DynamicRating           units.Value `json:"dynamicRating"`
+	HeavyDeviceWeight       units.Value `json:"heavyDeviceWeight"`
+	HeavyDeviceMaxElevation int         `json:"heavyDeviceMaxElevation,omitempty"`
+}
+
+func NewRackLoadConfiguredEvent(aggregate events.Aggregate, load datacenter.RackLoad) (events.Event, error) {
+	data := RackLoadConfiguredEvent{
+		StaticRating:            load.StaticRating,
+		DynamicRating:           load.DynamicRating,
+		HeavyDeviceWeight:       load.HeavyDeviceWeight,
+		HeavyDeviceMaxElevation: load.HeavyDeviceMaxElevation,
+	}
+	event := events.NewBaseEvent(aggregate, RackLoadConfigured)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type RackRUsReservedEvent struct {
+	// the reserved RU(s), in the syntax of ranges.ParseRange.
+	RUs        string   `json:"rus"`
+	Reason     string   `json:"reason"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+func NewRackRUsReservedEvent(aggregate events.Aggregate, reservation datacenter.Reservation) (events.Event, error) {
+	data := RackRUsReservedEvent{
+		RUs:        reservation.RUs.String(),
+		Reason:     reservation.Reason,
+		Categories: reservation.Categories,
+	}
+	event := events.NewBaseEvent(aggregate, RackRUsReserved)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type RackRUsReleasedEvent struct {
+	// the RU(s) the reservation was made with, in the syntax of ranges.ParseRange.
+	RUs    string `json:"rus"`
+	Reason string `json:"reason"`
+}
+
+func NewRackRUsReleasedEvent(aggregate events.Aggregate, rus string, reason string) (events.Event, error) {
+	data := RackRUsReleasedEvent{
+		RUs:    rus,
+		Reason: reason,
+	}
+	event := events.NewBaseEvent(aggregate, RackRUsReleased)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
 type DatacenterRackAddedEvent struct {
 	RackId string `json:"rackId"`
 }
@@ -116,9 +239,11 @@ type DeviceCreatedEvent struct {
 	Categories  []string               `json:"categories"`
 	PodId       string                 `json:"podId"`
 	RackId      string                 `json:"rackId"`
+	// the template the device was created with. empty for devices created before it was recorded.
+	TemplateId string `json:"templateId,omitempty"`
 }
 
-func NewDeviceCreatedEvent(aggregate events.Aggregate, hostname string, elevation int, designation datacenter.Designation, cluster, instance int, modelId string, categories []string, podId, rackId string) (events.Event, error) {
+func NewDeviceCreatedEvent(aggregate events.Aggregate, hostname string, elevation int, designation datacenter.Designation, cluster, instance int, modelId string, categories []string, podId, rackId, templateId string) (events.Event, error) {
 	data := DeviceCreatedEvent{
 		Hostname:    hostname,
 		Elevation:   elevation,
@@ -129,6 +254,7 @@ func NewDeviceCreatedEvent(aggregate events.Aggregate, hostname string, elevatio
 		Categories:  categories,
 		PodId:       podId,
 		RackId:      rackId,
+		TemplateId:  templateId,
 	}
 	event := events.NewBaseEvent(aggregate, DeviceCreated)
 	if err := event.SetJsonData(&data); err != nil {
@@ -137,43 +263,193 @@ func NewDeviceCreatedEvent(aggregate events.Aggregate, hostname string, elevatio
 	return event, nil
 }
 
+type DatacenterDeviceAddedEvent struct {
+	DeviceId string `json:"deviceId"`
+	ModelId  string `json:"modelId"`
+	Variant  string `json:"variant"`
+	// the instance the device was named after, 0 for devices added before it was recorded.
+	Instance int `json:"instance,omitempty"`
+}
+
+func NewDatacenterDeviceAddedEvent(aggregate events.Aggregate, deviceId, modelId, variant string, instance int) (events.Event, error) {
+	data := DatacenterDeviceAddedEvent{
+		DeviceId: deviceId,
+		ModelId:  modelId,
+		Variant:  variant,
+		Instance: instance,
+	}
+	event := events.NewBaseEvent(aggregate, DatacenterDeviceAdded)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
 type DeviceRackedEvent struct {
-	DeviceId   string `json:"deviceId"`
+	DeviceId     string          `json:"deviceId"`
+	Elevation    int             `json:"elevation"`
+	ModelId      string          `json:"modelId,omitempty"`
+	FormFactor   int             `json:"formFactor"`
+	Face         hardware.Face   `json:"face,omitempty"`
+	Depth        hardware.Depth  `json:"depth,omitempty"`
+	Width        hardware.Width  `json:"width,omitempty"`
+	Side         datacenter.Side `json:"side,omitempty"`
+	Weight       units.Value     `json:"weight"`
+	TypicalPower int             `json:"typicalPower,omitempty"`
+	MaxPower     int             `json:"maxPower,omitempty"`
+	PSUs         int             `json:"psus,omitempty"`
+	// the categories of the device, the reserved RU(s) of the rack it may be moved into depend on them.
+	Categories []string `json:"categories,omitempty"`
+	// the designation and the cluster of the device, a pair of devices is placed next to each other with them.
+	Designation datacenter.Designation `json:"designation,omitempty"`
+	Cluster     int                    `json:"cluster,omitempty"`
+}
+
+// NewDeviceRackedEvent records the device racked at the elevation in the side, with its model, categories, designation
+// and cluster.
+func NewDeviceRackedEvent(aggregate events.Aggregate, device *datacenter.Device, elevation int, side datacenter.Side) (events.Event, error) {
+	model := device.Model
+	data := DeviceRackedEvent{
+		DeviceId:     device.ID,
+		Elevation:    elevation,
+		ModelId:      model.ID,
+		FormFactor:   model.FormFactor,
+		Face:         model.Mounting.MountedFace(),
+		Depth:        model.Mounting.Depth,
+		Width:        model.Mounting.Width,
+		Side:         side,
+		Weight:       model.Weight,
+		TypicalPower: model.Power.Typical,
+		MaxPower:     model.Power.Max,
+		PSUs:         model.Power.PSUs,
+		Categories:   device.Categories,
+		Designation:  device.Designation,
+		Cluster:      device.Cluster,
+	}
+	event := events.NewBaseEvent(aggregate, DeviceRacked)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+
+}
+
+type DeviceUnrackedEvent struct {
+	DeviceId string `json:"deviceId"`
+	// the elevation the device was racked at.
 	Elevation  int    `json:"elevation"`
 	FormFactor int    `json:"formFactor"`
+	Reason     string `json:"reason"`
 }
 
-func NewDeviceRackedEvent(aggregate events.Aggregate, deviceId string, elevation int, formFactor int) (events.Event, error) {
-	data := DeviceRackedEvent{
+func NewDeviceUnrackedEvent(aggregate events.Aggregate, deviceId string, elevation int, formFactor int, reason string) (events.Event, error) {
+	data := DeviceUnrackedEvent{
 		DeviceId:   deviceId,
 		Elevation:  elevation,
 		FormFactor: formFactor,
+		Reason:     reason,
 	}
-	event := events.NewBaseEvent(aggregate, DeviceRacked)
+	event := events.NewBaseEvent(aggregate, DeviceUnracked)
 	if err := event.SetJsonData(&data); err != nil {
 		return events.Event{}, err
 	}
 	return event, nil
+}
 
+type DeviceRemovedEvent struct {
+	// the rack the device was removed from.
+	RackId string `json:"rackId"`
+	Reason string `json:"reason"`
+}
+
+func NewDeviceRemovedEvent(aggregate events.Aggregate, rackId string, reason string) (events.Event, error) {
+	data := DeviceRemovedEvent{
+		RackId: rackId,
+		Reason: reason,
+	}
+	event := events.NewBaseEvent(aggregate, DeviceRemoved)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type DeviceMovedEvent struct {
+	// the rack and elevation the device was moved from, empty if the device wasn't racked.
+	FromRackId    string `json:"fromRackId,omitempty"`
+	FromElevation int    `json:"fromElevation,omitempty"`
+	RackId        string `json:"rackId"`
+	Elevation     int    `json:"elevation"`
+	// the hostname of the device once moved, rendered again if it depends on the rack or elevation of the device.
+	Hostname string `json:"hostname"`
+}
+
+func NewDeviceMovedEvent(aggregate events.Aggregate, fromRackId string, fromElevation int, rackId string, elevation int, hostname string) (events.Event, error) {
+	data := DeviceMovedEvent{
+		FromRackId:    fromRackId,
+		FromElevation: fromElevation,
+		RackId:        rackId,
+		Elevation:     elevation,
+		Hostname:      hostname,
+	}
+	event := events.NewBaseEvent(aggregate, DeviceMoved)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
+}
+
+type DeviceDecommissionedEvent struct {
+	Reason string `json:"reason"`
+}
+
+func NewDeviceDecommissionedEvent(aggregate events.Aggregate, reason string) (events.Event, error) {
+	data := DeviceDecommissionedEvent{
+		Reason: reason,
+	}
+	event := events.NewBaseEvent(aggregate, DeviceDecommissioned)
+	if err := event.SetJsonData(&data); err != nil {
+		return events.Event{}, err
+	}
+	return event, nil
 }
 
 type DeviceTemplateCreatedEvent struct {
 	ModelId          string              `json:"modelId"`
+	FormFactor       int                 `json:"formFactor"`
+	Face             hardware.Face       `json:"face,omitempty"`
+	Depth            hardware.Depth      `json:"depth,omitempty"`
+	Width            hardware.Width      `json:"width,omitempty"`
+	Weight           units.Value         `json:"weight"`
+	TypicalPower     int                 `json:"typicalPower,omitempty"`
+	MaxPower         int                 `json:"maxPower,omitempty"`
+	PSUs             int                 `json:"psus,omitempty"`
 	Variant          string              `json:"variant"`
 	Categories       []string            `json:"categories"`
 	HostnameTemplate string              `json:"hostnameTemplate"`
 	Alias            string              `json:"alias"`
 	Function         datacenter.Function `json:"function"`
+	// how devices created using the template are placed when no elevation is given.
+	Placement datacenter.Placement `json:"placement,omitempty"`
 }
 
-func NewDeviceTemplateCreatedEvent(aggregate events.Aggregate, modelId, variant string, categories []string, hostnameTemplate, alias string, function datacenter.Function) (events.Event, error) {
+func NewDeviceTemplateCreatedEvent(aggregate events.Aggregate, modelId string, formFactor int, mounting hardware.Mounting, weight units.Value, power hardware.PowerDraw, variant string, categories []string, hostnameTemplate, alias string, function datacenter.Function, placement datacenter.Placement) (events.Event, error) {
 	data := DeviceTemplateCreatedEvent{
 		ModelId:          modelId,
+		FormFactor:       formFactor,
+		Face:             mounting.Face,
+		Depth:            mounting.Depth,
+		Width:            mounting.Width,
+		Weight:           weight,
+		TypicalPower:     power.Typical,
+		MaxPower:         power.Max,
+		PSUs:             power.PSUs,
 		Variant:          variant,
 		Categories:       categories,
 		HostnameTemplate: hostnameTemplate,
 		Alias:            alias,
 		Function:         function,
+		Placement:        placement,
 	}
 	event := events.NewBaseEvent(aggregate, DeviceTemplateCreated)
 	if err := event.SetJsonData(&data); err != nil {
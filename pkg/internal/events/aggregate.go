@@ -37,11 +37,14 @@ type Aggregate interface {
 
 type AggregateRoot interface {
 	GetUncommittedEvents() []Event
+	SetMetadata(metadata any) error
 	GetId() string
 	SetId(id string) *AggregateBase
 	GetVersion() int64
+	GetExpectedVersion() int64
 	ClearUncommittedEvents()
 	ToSnapshot()
+	RestoreVersion(version int64)
 	SetType(aggregateType AggregateType)
 	GetType() AggregateType
 	SetAppliedEvents(events []Event)
@@ -97,6 +100,13 @@ func (a *AggregateBase) GetVersion() int64 {
 	return a.Version
 }
 
+// GetExpectedVersion returns the version of the aggregate before any of its uncommitted events were applied.
+// it is the version the aggregate's stream is expected to be at when the uncommitted events are saved, a value
+// of -1 indicates that the stream is not expected to exist.
+func (a *AggregateBase) GetExpectedVersion() int64 {
+	return a.Version - int64(len(a.UncommittedEvents))
+}
+
 func (a *AggregateBase) ClearUncommittedEvents() {
 	a.UncommittedEvents = make([]Event, 0, aggregateUncommittedEventsInitialCap)
 }
@@ -113,13 +123,28 @@ func (a *AggregateBase) GetUncommittedEvents() []Event {
 	return a.UncommittedEvents
 }
 
+// SetMetadata sets the metadata of every uncommitted event of the aggregate.
+func (a *AggregateBase) SetMetadata(metadata any) error {
+	for i := range a.UncommittedEvents {
+		if err := a.UncommittedEvents[i].SetMetadata(metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *AggregateBase) Load(events []Event) error {
 	for _, evt := range events {
 		if evt.GetAggregateId() != a.GetId() {
 			return ErrInvalidAggregate
 		}
 
-		if err := a.when(evt); err != nil {
+		evt, err := Upcast(evt)
+		if err != nil {
+			return err
+		}
+
+		if err = a.when(evt); err != nil {
 			return err
 		}
 
@@ -157,9 +182,14 @@ func (a *AggregateBase) RaiseEvent(event Event) error {
 		return ErrInvalidEventVersion
 	}
 
+	event, err := Upcast(event)
+	if err != nil {
+		return err
+	}
+
 	event.SetAggregateType(a.GetType())
 
-	if err := a.when(event); err != nil {
+	if err = a.when(event); err != nil {
 		return err
 	}
 
@@ -177,6 +207,13 @@ func (a *AggregateBase) ToSnapshot() {
 	a.ClearUncommittedEvents()
 }
 
+// RestoreVersion sets the version of an aggregate whose state was restored from a snapshot taken at the passed version.
+// events loaded into the aggregate afterwards must follow on from the restored version.
+func (a *AggregateBase) RestoreVersion(version int64) {
+	a.Version = version
+	a.ClearUncommittedEvents()
+}
+
 func (a *AggregateBase) String() string {
 	return fmt.Sprintf("Id: {%s}, Version: {%v}, Type: {%v}, AppliedEvents: {%v}, UncommittedEvents: {%v}",
 		a.GetId(),
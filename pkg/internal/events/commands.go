@@ -1,11 +1,17 @@
 package events
 
+import "reflect"
+
 type Command interface {
 	GetAggregateId() string
+	GetCommandId() string
 }
 
 type BaseCommand struct {
 	AggregateId string `json:"aggregateId"`
+	// an optional id supplied by the client. commands with the same id, sent to the same aggregate,
+	// are only processed once.
+	CommandId string `json:"commandId,omitempty"`
 }
 
 func NewBaseCommand(aggregateId string) BaseCommand {
@@ -15,3 +21,50 @@ func NewBaseCommand(aggregateId string) BaseCommand {
 func (c *BaseCommand) GetAggregateId() string {
 	return c.AggregateId
 }
+
+func (c *BaseCommand) GetCommandId() string {
+	return c.CommandId
+}
+
+func (c *BaseCommand) SetCommandId(commandId string) {
+	c.CommandId = commandId
+}
+
+// Validator is implemented by commands that can check their fields before they are handled.
+type Validator interface {
+	// Validate returns an error wrapping ErrInvalidCommand if the command can't be handled.
+	Validate() error
+}
+
+// CommandName returns the name of the command's type (e.g. 'CreateRackCommand').
+func CommandName(cmd Command) string {
+	t := reflect.TypeOf(cmd)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// invalidCommandError is returned by Validator implementations whose commands are invalid because of a domain error
+// (e.g. a missing rack name). it matches both ErrInvalidCommand and the domain error with errors.Is.
+type invalidCommandError struct {
+	cause error
+}
+
+// NewInvalidCommandError returns an error that wraps ErrInvalidCommand and the domain error that makes the command
+// invalid.
+func NewInvalidCommandError(cause error) error {
+	return &invalidCommandError{cause: cause}
+}
+
+func (e *invalidCommandError) Error() string {
+	return ErrInvalidCommand.Error() + ": " + e.cause.Error()
+}
+
+func (e *invalidCommandError) Is(target error) bool {
+	return target == ErrInvalidCommand
+}
+
+func (e *invalidCommandError) Unwrap() error {
+	return e.cause
+}
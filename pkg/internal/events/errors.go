@@ -7,7 +7,10 @@ var (
 	ErrAggregateNotFound   = errors.New("aggregate not found")
 	ErrInvalidEventType    = errors.New("invalid event type")
 	ErrInvalidCommandType  = errors.New("invalid command type")
+	ErrInvalidCommand      = errors.New("invalid command")
 	ErrInvalidAggregate    = errors.New("invalid aggregate")
 	ErrInvalidAggregateId  = errors.New("invalid aggregate id")
 	ErrInvalidEventVersion = errors.New("invalid event version")
+	ErrConcurrencyConflict = errors.New("concurrency conflict")
+	ErrSnapshotNotFound    = errors.New("snapshot not found")
 )
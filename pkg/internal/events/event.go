@@ -20,6 +20,11 @@ type Event struct {
 	AggregateId   string
 	Version       int64
 	Metadata      []byte
+	// the position of the event within the log of all streams. only set on events read from a store.
+	Position uint64
+	// the prepare position of the event within the log of all streams, only set by stores that position events with a
+	// pair of numbers. see LogPosition.
+	PreparePosition uint64
 }
 
 func NewBaseEvent(aggregate Aggregate, eventType EventType) Event {
@@ -42,6 +47,9 @@ func NewEventFromRecorded(event *esdb.RecordedEvent) Event {
 		AggregateId: event.StreamID,
 		Version:     int64(event.EventNumber),
 		Metadata:    event.UserMetadata,
+		Position:    event.Position.Commit,
+
+		PreparePosition: event.Position.Prepare,
 	}
 }
 
@@ -118,6 +126,19 @@ func (e *Event) SetVersion(version int64) {
 	e.Version = version
 }
 
+func (e *Event) GetPosition() uint64 {
+	return e.Position
+}
+
+func (e *Event) SetPosition(position uint64) {
+	e.Position = position
+}
+
+// GetLogPosition returns the position of the event to read the log of all streams after, see AllEventsReader.
+func (e *Event) GetLogPosition() LogPosition {
+	return LogPosition{Commit: e.Position, Prepare: e.PreparePosition}
+}
+
 func (e *Event) GetMetadata() []byte {
 	return e.Metadata
 }
@@ -0,0 +1,34 @@
+package events
+
+import "context"
+
+// EventMetadata is the standard metadata envelope attached to events. it records what produced an event so that
+// events can be traced back to the planning session or API call they originated from.
+type EventMetadata struct {
+	// identifies the session or request the event belongs to. every event produced by the same
+	// session or request shares a correlation id.
+	CorrelationId string `json:"correlationId,omitempty"`
+	// the id of the command or event that directly caused the event.
+	CausationId string `json:"causationId,omitempty"`
+	// the user or service that issued the command.
+	Actor string `json:"actor,omitempty"`
+	// the name of the command that produced the event.
+	Command string `json:"command,omitempty"`
+	// the version of the client that issued the command.
+	ClientVersion string `json:"clientVersion,omitempty"`
+	// the host that handled the command.
+	SourceHost string `json:"sourceHost,omitempty"`
+}
+
+type metadataContextKey struct{}
+
+// ContextWithMetadata returns a copy of ctx that carries the passed metadata.
+func ContextWithMetadata(ctx context.Context, metadata EventMetadata) context.Context {
+	return context.WithValue(ctx, metadataContextKey{}, metadata)
+}
+
+// MetadataFromContext returns the metadata carried by ctx. the zero EventMetadata is returned if ctx carries none.
+func MetadataFromContext(ctx context.Context) EventMetadata {
+	metadata, _ := ctx.Value(metadataContextKey{}).(EventMetadata)
+	return metadata
+}
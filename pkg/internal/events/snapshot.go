@@ -0,0 +1,45 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Snapshot is the serialized state of an aggregate at a version.
+type Snapshot struct {
+	AggregateId   string        `json:"aggregateId"`
+	AggregateType AggregateType `json:"aggregateType"`
+	// the version of the aggregate when the snapshot was taken.
+	Version int64 `json:"version"`
+	// the version of the schema the state was serialized with.
+	SchemaVersion int             `json:"schemaVersion"`
+	State         json.RawMessage `json:"state"`
+	Timestamp     time.Time       `json:"timestamp"`
+}
+
+// SnapshotSerializer converts the state of an aggregate type to and from the state of a Snapshot.
+type SnapshotSerializer interface {
+	// AggregateType returns the type of aggregate handled by the serializer.
+	AggregateType() AggregateType
+
+	// SchemaVersion returns the version of the schema the serializer writes. snapshots written with any other
+	// schema version are discarded and the aggregate is rebuilt from its events.
+	SchemaVersion() int
+
+	// Serialize returns the serialized state of the aggregate.
+	Serialize(aggregate Aggregate) ([]byte, error)
+
+	// Deserialize restores the state of the aggregate from serialized state.
+	Deserialize(aggregate Aggregate, state []byte) error
+}
+
+func NewSnapshot(aggregate Aggregate, schemaVersion int, state []byte) Snapshot {
+	return Snapshot{
+		AggregateId:   aggregate.GetId(),
+		AggregateType: aggregate.GetType(),
+		Version:       aggregate.GetVersion(),
+		SchemaVersion: schemaVersion,
+		State:         state,
+		Timestamp:     time.Now().UTC(),
+	}
+}
@@ -5,12 +5,15 @@ import "context"
 // AggregateStore is responsible for loading and saving aggregates.
 type AggregateStore interface {
 	// Load loads the most recent version of an aggregate to provided into params aggregate with a type and id.
+	// ErrAggregateNotFound is returned if no events exist for the aggregate.
 	Load(ctx context.Context, aggregate Aggregate) error
 
-	// Save saves the uncommitted events for an aggregate.
+	// Save saves the uncommitted events for an aggregate. the aggregate's stream must be at the aggregate's
+	// expected version (see AggregateRoot.GetExpectedVersion), otherwise ErrConcurrencyConflict is returned.
+	// ErrAlreadyExists is returned if a new aggregate is saved with the id of an existing aggregate.
 	Save(ctx context.Context, aggregate Aggregate) error
 
-	// Exists check aggregate exists by id.
+	// Exists check aggregate exists by id. ErrAggregateNotFound is returned if it does not.
 	Exists(ctx context.Context, streamId string) error
 }
 
@@ -20,5 +23,47 @@ type EventStore interface {
 	SaveEvents(ctx context.Context, events []Event) error
 
 	// LoadEvents loads all events for the aggregate id from the store.
+	// ErrAggregateNotFound is returned if no events exist for the aggregate id.
 	LoadEvents(ctx context.Context, streamId string) ([]Event, error)
+
+	// LoadEventsFrom is like LoadEvents but only loads the events with a version greater than or equal to from.
+	LoadEventsFrom(ctx context.Context, streamId string, from int64) ([]Event, error)
+
+	// LoadLastEvent loads the last event of the stream without reading the events before it.
+	// ErrAggregateNotFound is returned if no events exist for the stream.
+	LoadLastEvent(ctx context.Context, streamId string) (Event, error)
+
+	// SetMaxCount bounds the stream to its last maxCount events, the events before them are discarded. the versions of
+	// the events kept, and of the events appended afterwards, are unchanged.
+	SetMaxCount(ctx context.Context, streamId string, maxCount int) error
+}
+
+// SnapshotStore is responsible for saving and loading aggregate snapshots.
+type SnapshotStore interface {
+	// SaveSnapshot saves the snapshot, replacing any earlier snapshot of the same aggregate.
+	SaveSnapshot(ctx context.Context, snapshot Snapshot) error
+
+	// LoadSnapshot loads the latest snapshot of the aggregate with the passed id.
+	// ErrSnapshotNotFound is returned if no snapshot exists for the aggregate.
+	LoadSnapshot(ctx context.Context, aggregateId string) (Snapshot, error)
+}
+
+// AllEventsReader reads the events of every stream in the order they were committed.
+type AllEventsReader interface {
+	// ReadAll loads up to count events, across all streams, committed after the passed position.
+	// the zero LogPosition reads from the beginning of the log. see Event.GetLogPosition.
+	ReadAll(ctx context.Context, after LogPosition, count int) ([]Event, error)
+}
+
+// LogPosition is the position of an event within the log of all streams. most stores position events with a single
+// number, the Commit position. EventStoreDB positions them with both a commit and a prepare position, a LogPosition
+// without a Prepare position is resumed from approximately.
+type LogPosition struct {
+	Commit  uint64
+	Prepare uint64
+}
+
+// NewLogPosition returns the LogPosition of the passed commit position.
+func NewLogPosition(commit uint64) LogPosition {
+	return LogPosition{Commit: commit}
 }
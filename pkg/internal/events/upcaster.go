@@ -0,0 +1,62 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+)
+
+// the maximum number of upcasters applied to a single event, guards against upcasters that form a cycle.
+const maxUpcastDepth = 16
+
+// Upcaster converts a stored event into the shape expected by the current version of its event type. an upcaster
+// may also change the type of the event (e.g. V1_POD_CREATED -> V2_POD_CREATED), in which case the upcaster
+// registered for the new type is applied in turn. this allows a new version of an event to be introduced without
+// breaking the replay of streams that hold the old version.
+type Upcaster func(event Event) (Event, error)
+
+// globalUpcasters is the collection of Upcasters, indexed by the event type they upcast, that is shared globally.
+var (
+	globalUpcasters     = map[EventType]Upcaster{}
+	globalUpcastersLock = sync.RWMutex{}
+)
+
+// RegisterUpcaster registers the upcaster for events of the passed type, replacing any previously registered upcaster.
+func RegisterUpcaster(eventType EventType, upcaster Upcaster) {
+	globalUpcastersLock.Lock()
+	defer globalUpcastersLock.Unlock()
+
+	globalUpcasters[eventType] = upcaster
+}
+
+func getUpcaster(eventType EventType) (Upcaster, bool) {
+	globalUpcastersLock.RLock()
+	defer globalUpcastersLock.RUnlock()
+
+	upcaster, ok := globalUpcasters[eventType]
+	return upcaster, ok
+}
+
+// Upcast applies the registered upcasters to the event until it is in the shape of the current version of its type.
+// events without a registered upcaster are returned unchanged.
+func Upcast(event Event) (Event, error) {
+	for depth := 0; depth < maxUpcastDepth; depth++ {
+		upcaster, ok := getUpcaster(event.GetEventType())
+		if !ok {
+			return event, nil
+		}
+
+		eventType := event.GetEventType()
+		upcasted, err := upcaster(event)
+		if err != nil {
+			return Event{}, fmt.Errorf("upcast {%s}: %w", eventType, err)
+		}
+
+		event = upcasted
+		// an upcaster that keeps the event type has brought the event up to date.
+		if event.GetEventType() == eventType {
+			return event, nil
+		}
+	}
+
+	return Event{}, fmt.Errorf("%w: upcasting exceeded %d steps, event type {%s}", ErrInvalidEventType, maxUpcastDepth, event.GetEventType())
+}
@@ -0,0 +1,175 @@
+package events
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	// a rack sized in RUs, e.g. {"units": 42}.
+	testRackSizedV1 EventType = "TEST_V1_RACK_SIZED"
+	// a rack sized in a unit, e.g. {"size": {"value": 42, "unit": "RU"}}.
+	testRackSizedV2 EventType = "TEST_V2_RACK_SIZED"
+)
+
+type testRackSizedV1Event struct {
+	Units int `json:"units"`
+}
+
+type testRackSize struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"`
+}
+
+type testRackSizedV2Event struct {
+	Size testRackSize `json:"size"`
+}
+
+func init() {
+	// V1 events are converted to V2 events without a unit, which the V2 upcaster fills in.
+	RegisterUpcaster(testRackSizedV1, func(event Event) (Event, error) {
+		var v1 testRackSizedV1Event
+		if err := event.GetJsonData(&v1); err != nil {
+			return Event{}, err
+		}
+		event.EventType = testRackSizedV2
+		if err := event.SetJsonData(testRackSizedV2Event{Size: testRackSize{Value: v1.Units}}); err != nil {
+			return Event{}, err
+		}
+		return event, nil
+	})
+	RegisterUpcaster(testRackSizedV2, func(event Event) (Event, error) {
+		var v2 testRackSizedV2Event
+		if err := event.GetJsonData(&v2); err != nil {
+			return Event{}, err
+		}
+		if v2.Size.Unit != "" {
+			return event, nil
+		}
+		v2.Size.Unit = "RU"
+		if err := event.SetJsonData(v2); err != nil {
+			return Event{}, err
+		}
+		return event, nil
+	})
+}
+
+func newTestEvent(t *testing.T, eventType EventType, data any) Event {
+	t.Helper()
+
+	event := Event{EventId: "e1", EventType: eventType, AggregateId: "rack-r1"}
+	if err := event.SetJsonData(data); err != nil {
+		t.Fatalf("SetJsonData: %v", err)
+	}
+	return event
+}
+
+func TestUpcastChain(t *testing.T) {
+	tests := []struct {
+		name  string
+		event Event
+	}{
+		{name: "v1", event: newTestEvent(t, testRackSizedV1, testRackSizedV1Event{Units: 42})},
+		{name: "v2 without a unit", event: newTestEvent(t, testRackSizedV2, map[string]any{"size": map[string]any{"value": 42}})},
+		{name: "current", event: newTestEvent(t, testRackSizedV2, testRackSizedV2Event{Size: testRackSize{Value: 42, Unit: "RU"}})},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			upcasted, err := Upcast(test.event)
+			if err != nil {
+				t.Fatalf("Upcast: %v", err)
+			}
+			if upcasted.GetEventType() != testRackSizedV2 || upcasted.GetEventId() != "e1" {
+				t.Fatalf("expected the event e1 as a %s, got %s %s", testRackSizedV2, upcasted.GetEventId(), upcasted.GetEventType())
+			}
+			var data testRackSizedV2Event
+			if err = upcasted.GetJsonData(&data); err != nil {
+				t.Fatalf("GetJsonData: %v", err)
+			}
+			if data.Size != (testRackSize{Value: 42, Unit: "RU"}) {
+				t.Fatalf("expected a size of 42 RU, got %+v", data.Size)
+			}
+		})
+	}
+}
+
+func TestUpcastWithoutUpcaster(t *testing.T) {
+	event := Event{EventType: "TEST_NOT_UPCAST", Data: []byte("{")}
+	upcasted, err := Upcast(event)
+	if err != nil {
+		t.Fatalf("Upcast: %v", err)
+	}
+	if upcasted.GetEventType() != event.GetEventType() || string(upcasted.GetData()) != "{" {
+		t.Fatalf("expected the event unchanged, got %s %s", upcasted.GetEventType(), upcasted.GetData())
+	}
+}
+
+func TestUpcastErrors(t *testing.T) {
+	// an upcaster that fails is reported with the type it upcasts.
+	if _, err := Upcast(Event{EventType: testRackSizedV1, Data: []byte("{")}); err == nil {
+		t.Fatalf("expected the malformed payload to fail to upcast")
+	}
+
+	// TEST_CYCLE_A and TEST_CYCLE_B upcast to each other.
+	calls := 0
+	for from, to := range map[EventType]EventType{"TEST_CYCLE_A": "TEST_CYCLE_B", "TEST_CYCLE_B": "TEST_CYCLE_A"} {
+		to := to
+		RegisterUpcaster(from, func(event Event) (Event, error) {
+			calls++
+			event.EventType = to
+			return event, nil
+		})
+	}
+	if _, err := Upcast(Event{EventType: "TEST_CYCLE_A"}); !errors.Is(err, ErrInvalidEventType) {
+		t.Fatalf("expected %v, got %v", ErrInvalidEventType, err)
+	}
+	if calls != maxUpcastDepth {
+		t.Fatalf("expected the cycle to be broken after %d upcasts, got %d", maxUpcastDepth, calls)
+	}
+}
+
+// testRack is an aggregate that only knows the current shape of the event.
+type testRack struct {
+	*AggregateBase
+	size testRackSize
+}
+
+func newTestRack() *testRack {
+	rack := &testRack{}
+	rack.AggregateBase = NewAggregateBase(rack.When)
+	rack.SetType("rack")
+	rack.SetId("r1")
+	return rack
+}
+
+func (r *testRack) When(event Event) error {
+	if event.GetEventType() != testRackSizedV2 {
+		return ErrInvalidEventType
+	}
+	var data testRackSizedV2Event
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+	r.size = data.Size
+	return nil
+}
+
+func TestReplayLegacyEvent(t *testing.T) {
+	// the payload of a stored V1 event, as it is read back from a store.
+	stored := Event{EventId: "e1", EventType: testRackSizedV1, AggregateId: "rack-r1", Data: []byte(`{"units":45}`)}
+
+	raised := newTestRack()
+	if err := raised.RaiseEvent(stored); err != nil {
+		t.Fatalf("RaiseEvent: %v", err)
+	}
+	loaded := newTestRack()
+	if err := loaded.Load([]Event{stored}); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	for _, rack := range []*testRack{raised, loaded} {
+		if rack.size != (testRackSize{Value: 45, Unit: "RU"}) || rack.GetVersion() != 0 {
+			t.Fatalf("expected a rack of 45 RU at version 0, got %+v at version %d", rack.size, rack.GetVersion())
+		}
+	}
+}
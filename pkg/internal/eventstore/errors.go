@@ -0,0 +1,9 @@
+package eventstore
+
+import "errors"
+
+var (
+	ErrStoreLocked   = errors.New("store locked")
+	ErrStoreClosed   = errors.New("store closed")
+	ErrCorruptStream = errors.New("corrupt stream")
+)
@@ -0,0 +1,243 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// the maximum number of events read from a stream in a single read.
+const readCount = math.MaxInt64
+
+// esdbStore is an EventStoreDB backed implementation of events.AggregateStore and events.EventStore.
+// every aggregate is persisted to its own stream, named after the aggregate's id.
+type esdbStore struct {
+	log logger.Logger
+	db  EsdbClient
+}
+
+func NewEsdbStore(log logger.Logger, db EsdbClient) *esdbStore {
+	return &esdbStore{log: log, db: db}
+}
+
+func (s *esdbStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	stream, err := s.db.ReadStream(ctx, aggregate.GetId(), esdb.ReadStreamOptions{}, readCount)
+	if err != nil {
+		return mapEsdbError(aggregate.GetId(), err)
+	}
+	defer stream.Close()
+
+	for {
+		resolved, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return mapEsdbError(aggregate.GetId(), err)
+		}
+
+		if err = aggregate.RaiseEvent(events.NewEventFromRecorded(resolved.Event)); err != nil {
+			return fmt.Errorf("RaiseEvent: %w", err)
+		}
+	}
+
+	s.log.Debugf("loaded aggregate: %s", aggregate.String())
+	return nil
+}
+
+func (s *esdbStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	uncommitted := aggregate.GetUncommittedEvents()
+	if len(uncommitted) == 0 {
+		return nil
+	}
+
+	eventsData := make([]esdb.EventData, 0, len(uncommitted))
+	for i := range uncommitted {
+		eventsData = append(eventsData, uncommitted[i].ToEventData())
+	}
+
+	expected := toExpectedRevision(aggregate.GetExpectedVersion())
+	_, err := s.db.AppendToStream(ctx, aggregate.GetId(), esdb.AppendToStreamOptions{ExpectedRevision: expected}, eventsData...)
+	if err != nil {
+		if errors.Is(err, esdb.ErrWrongExpectedStreamRevision) {
+			if _, isNew := expected.(esdb.NoStream); isNew {
+				return fmt.Errorf("%w {%s}", events.ErrAlreadyExists, aggregate.GetId())
+			}
+			return fmt.Errorf("%w: stream {%s} is not at expected version {%d}", events.ErrConcurrencyConflict, aggregate.GetId(), aggregate.GetExpectedVersion())
+		}
+		return mapEsdbError(aggregate.GetId(), err)
+	}
+
+	s.log.Debugf("saved aggregate: %s", aggregate.String())
+	aggregate.ToSnapshot()
+	return nil
+}
+
+func (s *esdbStore) Exists(ctx context.Context, streamId string) error {
+	stream, err := s.db.ReadStream(ctx, streamId, esdb.ReadStreamOptions{Direction: esdb.Backwards, From: esdb.End{}}, 1)
+	if err != nil {
+		return mapEsdbError(streamId, err)
+	}
+	defer stream.Close()
+
+	if _, err = stream.Recv(); err != nil && !errors.Is(err, io.EOF) {
+		return mapEsdbError(streamId, err)
+	}
+
+	return nil
+}
+
+func (s *esdbStore) SaveEvents(ctx context.Context, evts []events.Event) error {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	streamId := evts[0].GetAggregateId()
+	eventsData := make([]esdb.EventData, 0, len(evts))
+	for i := range evts {
+		if evts[i].GetAggregateId() != streamId {
+			return fmt.Errorf("%w: events for {%s} and {%s} cannot be saved together", events.ErrInvalidAggregateId, streamId, evts[i].GetAggregateId())
+		}
+		eventsData = append(eventsData, evts[i].ToEventData())
+	}
+
+	if _, err := s.db.AppendToStream(ctx, streamId, esdb.AppendToStreamOptions{}, eventsData...); err != nil {
+		return mapEsdbError(streamId, err)
+	}
+
+	return nil
+}
+
+func (s *esdbStore) LoadEvents(ctx context.Context, streamId string) ([]events.Event, error) {
+	return s.readStream(ctx, streamId, esdb.Start{})
+}
+
+func (s *esdbStore) LoadEventsFrom(ctx context.Context, streamId string, from int64) ([]events.Event, error) {
+	if from <= 0 {
+		return s.readStream(ctx, streamId, esdb.Start{})
+	}
+	return s.readStream(ctx, streamId, esdb.Revision(uint64(from)))
+}
+
+func (s *esdbStore) LoadLastEvent(ctx context.Context, streamId string) (events.Event, error) {
+	stream, err := s.db.ReadStream(ctx, streamId, esdb.ReadStreamOptions{Direction: esdb.Backwards, From: esdb.End{}}, 1)
+	if err != nil {
+		return events.Event{}, mapEsdbError(streamId, err)
+	}
+	defer stream.Close()
+
+	resolved, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return events.Event{}, fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+	if err != nil {
+		return events.Event{}, mapEsdbError(streamId, err)
+	}
+
+	return events.NewEventFromRecorded(resolved.Event), nil
+}
+
+// SetMaxCount sets the $maxCount of the stream's metadata, EventStoreDB stops returning the events before the last
+// maxCount ones and scavenges them.
+func (s *esdbStore) SetMaxCount(ctx context.Context, streamId string, maxCount int) error {
+	var metadata esdb.StreamMetadata
+	metadata.SetMaxCount(uint64(maxCount))
+
+	_, err := s.db.SetStreamMetadata(ctx, streamId, esdb.AppendToStreamOptions{}, metadata)
+	return err
+}
+
+// readStream reads the events of the stream forwards, beginning at the passed position.
+func (s *esdbStore) readStream(ctx context.Context, streamId string, from esdb.StreamPosition) ([]events.Event, error) {
+	stream, err := s.db.ReadStream(ctx, streamId, esdb.ReadStreamOptions{From: from}, readCount)
+	if err != nil {
+		return nil, mapEsdbError(streamId, err)
+	}
+	defer stream.Close()
+
+	evts := make([]events.Event, 0)
+	for {
+		resolved, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, mapEsdbError(streamId, err)
+		}
+		evts = append(evts, events.NewEventFromRecorded(resolved.Event))
+	}
+
+	return evts, nil
+}
+
+// ReadAll loads up to count events, across all streams, committed after the passed position.
+// EventStoreDB's system events are skipped. a position without a prepare position is approximated by its commit
+// position, the events at or before it are skipped.
+func (s *esdbStore) ReadAll(ctx context.Context, after events.LogPosition, count int) ([]events.Event, error) {
+	var from esdb.AllPosition = esdb.Start{}
+	switch {
+	case after.Prepare > 0:
+		from = esdb.Position{Commit: after.Commit, Prepare: after.Prepare}
+	case after.Commit > 0:
+		from = esdb.Position{Commit: after.Commit, Prepare: after.Commit}
+	}
+
+	// the read is closed once enough events have been received, so there's no need to limit it. limiting it to count
+	// would stall the caller on any batch made up entirely of skipped events.
+	stream, err := s.db.ReadAll(ctx, esdb.ReadAllOptions{From: from}, readCount)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	evts := make([]events.Event, 0)
+	for count <= 0 || len(evts) < count {
+		resolved, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		recorded := resolved.OriginalEvent()
+		if !isAfter(recorded.Position, after) || strings.HasPrefix(recorded.EventType, "$") {
+			continue
+		}
+		evts = append(evts, events.NewEventFromRecorded(recorded))
+	}
+
+	return evts, nil
+}
+
+// isAfter returns true if the position is after the passed position, by commit and then by prepare position.
+func isAfter(position esdb.Position, after events.LogPosition) bool {
+	if position.Commit != after.Commit {
+		return position.Commit > after.Commit
+	}
+	// without a prepare position every event of the commit is skipped.
+	return after.Prepare > 0 && position.Prepare > after.Prepare
+}
+
+// toExpectedRevision converts an expected aggregate version into the revision expected by EventStoreDB.
+func toExpectedRevision(version int64) esdb.ExpectedRevision {
+	if version < 0 {
+		return esdb.NoStream{}
+	}
+	return esdb.Revision(uint64(version))
+}
+
+// mapEsdbError translates errors returned by the EventStoreDB client into their events package equivalent.
+func mapEsdbError(streamId string, err error) error {
+	if errors.Is(err, esdb.ErrStreamNotFound) {
+		return fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+	return err
+}
@@ -0,0 +1,288 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+	"github.com/gofrs/uuid"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// fakeEsdbClient is an in-process EsdbClient that mimics how EventStoreDB checks expected revisions and reports
+// missing streams.
+type fakeEsdbClient struct {
+	mu        sync.Mutex
+	streams   map[string][]*esdb.RecordedEvent
+	all       []*esdb.RecordedEvent
+	maxCounts map[string]uint64
+}
+
+func newFakeEsdbClient() *fakeEsdbClient {
+	return &fakeEsdbClient{streams: make(map[string][]*esdb.RecordedEvent), maxCounts: make(map[string]uint64)}
+}
+
+func (c *fakeEsdbClient) AppendToStream(_ context.Context, streamId string, opts esdb.AppendToStreamOptions, evts ...esdb.EventData) (*esdb.WriteResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stream, exists := c.streams[streamId]
+	last := int64(-1)
+	if exists {
+		last = int64(stream[len(stream)-1].EventNumber)
+	}
+	switch expected := opts.ExpectedRevision.(type) {
+	case esdb.NoStream:
+		if exists {
+			return nil, esdb.ErrWrongExpectedStreamRevision
+		}
+	case esdb.StreamExists:
+		if !exists {
+			return nil, esdb.ErrWrongExpectedStreamRevision
+		}
+	case esdb.StreamRevision:
+		if !exists || last != int64(expected.Value) {
+			return nil, esdb.ErrWrongExpectedStreamRevision
+		}
+	}
+
+	// like EventStoreDB, the events appended together share a commit position but have their own prepare position.
+	commit := uint64(len(c.all) + len(evts))
+	for i, evt := range evts {
+		prepare := uint64(len(c.all) + 1)
+		recorded := &esdb.RecordedEvent{
+			EventID:      uuid.Must(uuid.NewV4()),
+			EventType:    evt.EventType,
+			StreamID:     streamId,
+			EventNumber:  uint64(last + 1 + int64(i)),
+			Position:     esdb.Position{Commit: commit, Prepare: prepare},
+			Data:         evt.Data,
+			UserMetadata: evt.Metadata,
+		}
+		stream = append(stream, recorded)
+		c.all = append(c.all, recorded)
+	}
+	c.streams[streamId] = stream
+	c.trim(streamId)
+
+	stream = c.streams[streamId]
+	return &esdb.WriteResult{NextExpectedVersion: stream[len(stream)-1].EventNumber}, nil
+}
+
+func (c *fakeEsdbClient) SetStreamMetadata(_ context.Context, streamId string, _ esdb.AppendToStreamOptions, metadata esdb.StreamMetadata) (*esdb.WriteResult, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if maxCount := metadata.MaxCount(); maxCount != nil {
+		c.maxCounts[streamId] = *maxCount
+	}
+	c.trim(streamId)
+	return &esdb.WriteResult{}, nil
+}
+
+// trim drops the events of the stream before its last $maxCount events, like the scavenged events of EventStoreDB they
+// are never read again. the caller must hold the lock.
+func (c *fakeEsdbClient) trim(streamId string) {
+	stream := c.streams[streamId]
+	if maxCount, ok := c.maxCounts[streamId]; ok && uint64(len(stream)) > maxCount {
+		c.streams[streamId] = stream[uint64(len(stream))-maxCount:]
+	}
+}
+
+func (c *fakeEsdbClient) ReadStream(_ context.Context, streamId string, opts esdb.ReadStreamOptions, count uint64) (EsdbReadStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stream, ok := c.streams[streamId]
+	if !ok {
+		return nil, esdb.ErrStreamNotFound
+	}
+
+	read := make([]*esdb.RecordedEvent, 0, len(stream))
+	if opts.Direction == esdb.Backwards {
+		for i := len(stream) - 1; i >= 0; i-- {
+			read = append(read, stream[i])
+		}
+	} else {
+		from := uint64(0)
+		if revision, ok := opts.From.(esdb.StreamRevision); ok {
+			from = revision.Value
+		}
+		for _, evt := range stream {
+			if evt.EventNumber >= from {
+				read = append(read, evt)
+			}
+		}
+	}
+	return newFakeReadStream(read, count), nil
+}
+
+func (c *fakeEsdbClient) ReadAll(_ context.Context, _ esdb.ReadAllOptions, count uint64) (EsdbReadStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return newFakeReadStream(append([]*esdb.RecordedEvent(nil), c.all...), count), nil
+}
+
+type fakeReadStream struct {
+	evts []*esdb.RecordedEvent
+}
+
+func newFakeReadStream(evts []*esdb.RecordedEvent, count uint64) *fakeReadStream {
+	if uint64(len(evts)) > count {
+		evts = evts[:count]
+	}
+	return &fakeReadStream{evts: evts}
+}
+
+func (s *fakeReadStream) Recv() (*esdb.ResolvedEvent, error) {
+	if len(s.evts) == 0 {
+		return nil, io.EOF
+	}
+	evt := s.evts[0]
+	s.evts = s.evts[1:]
+	return &esdb.ResolvedEvent{Event: evt}, nil
+}
+
+func (s *fakeReadStream) Close() {}
+
+// newPod returns a pod aggregate with an uncommitted creation event.
+func newPod(t *testing.T, id string) *podAggregate.PodAggregate {
+	t.Helper()
+
+	dc := datacenter.NewDatacenter()
+	dc.ID = "dc1"
+	pod := podAggregate.NewPodAggregateWithId(id)
+	if err := pod.CreatePod(context.Background(), "compute", dc); err != nil {
+		t.Fatalf("CreatePod: %v", err)
+	}
+	return pod
+}
+
+func TestEsdbStoreLoadNotFound(t *testing.T) {
+	store := NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient())
+
+	pod := podAggregate.NewPodAggregateWithId("p1")
+	if err := store.Load(context.Background(), pod); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("Load: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+	if err := store.Exists(context.Background(), pod.GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("Exists: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+	if _, err := store.LoadEvents(context.Background(), pod.GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("LoadEvents: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+}
+
+func TestEsdbStoreSaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	store := NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient())
+
+	if err := store.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded := podAggregate.NewPodAggregateWithId("p1")
+	if err := store.Load(ctx, loaded); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.GetVersion() != 0 || loaded.Pod.Function != datacenter.ComputeFunction {
+		t.Fatalf("expected a compute pod at version 0, got %s at version %d", loaded.Pod.Function, loaded.GetVersion())
+	}
+
+	evts, err := store.ReadAll(ctx, events.LogPosition{}, 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(evts) != 1 || evts[0].GetAggregateId() != loaded.GetId() {
+		t.Fatalf("expected the pod's creation event, got %v", evts)
+	}
+}
+
+func TestEsdbStoreSaveAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	store := NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient())
+
+	if err := store.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save(ctx, newPod(t, "p1")); !errors.Is(err, events.ErrAlreadyExists) {
+		t.Fatalf("expected %v, got %v", events.ErrAlreadyExists, err)
+	}
+}
+
+func TestEsdbStoreSaveVersionConflict(t *testing.T) {
+	ctx := context.Background()
+	store := NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient())
+
+	if err := store.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// both loads see version 0, only the first deletion can be saved.
+	first, second := podAggregate.NewPodAggregateWithId("p1"), podAggregate.NewPodAggregateWithId("p1")
+	for _, pod := range []*podAggregate.PodAggregate{first, second} {
+		if err := store.Load(ctx, pod); err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if err := pod.DeletePod(ctx, "test"); err != nil {
+			t.Fatalf("DeletePod: %v", err)
+		}
+	}
+
+	if err := store.Save(ctx, first); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	err := store.Save(ctx, second)
+	if !errors.Is(err, events.ErrConcurrencyConflict) {
+		t.Fatalf("expected %v, got %v", events.ErrConcurrencyConflict, err)
+	}
+	if errors.Is(err, events.ErrAlreadyExists) {
+		t.Fatalf("a version conflict on an existing stream isn't %v", events.ErrAlreadyExists)
+	}
+}
+
+func TestEsdbStoreReadAllAfter(t *testing.T) {
+	ctx := context.Background()
+	store := NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient())
+
+	// both events are appended together and share a commit position.
+	pod := newPod(t, "p1")
+	if err := pod.DeletePod(ctx, "test"); err != nil {
+		t.Fatalf("DeletePod: %v", err)
+	}
+	if err := store.Save(ctx, pod); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	evts, err := store.ReadAll(ctx, events.LogPosition{}, 1)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(evts) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(evts))
+	}
+
+	after, err := store.ReadAll(ctx, evts[0].GetLogPosition(), 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(after) != 1 || after[0].GetVersion() != 1 {
+		t.Fatalf("expected the event after the first one, got %v", after)
+	}
+
+	// without its prepare position the whole commit is skipped.
+	approximate, err := store.ReadAll(ctx, events.NewLogPosition(evts[0].GetPosition()), 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(approximate) != 0 {
+		t.Fatalf("expected no events, got %v", approximate)
+	}
+}
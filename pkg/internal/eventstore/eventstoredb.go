@@ -0,0 +1,67 @@
+package eventstore
+
+import (
+	"context"
+
+	"github.com/EventStore/EventStore-Client-Go/esdb"
+)
+
+type Config struct {
+	ConnectionString string
+}
+
+func NewEventStoreDBConn(cfg *Config) (*esdb.Client, error) {
+	settings, err := esdb.ParseConnectionString(cfg.ConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdb.NewClient(settings)
+}
+
+// EsdbClient is the part of the EventStoreDB client the esdb store depends on.
+type EsdbClient interface {
+	AppendToStream(ctx context.Context, streamId string, opts esdb.AppendToStreamOptions, events ...esdb.EventData) (*esdb.WriteResult, error)
+	ReadStream(ctx context.Context, streamId string, opts esdb.ReadStreamOptions, count uint64) (EsdbReadStream, error)
+	ReadAll(ctx context.Context, opts esdb.ReadAllOptions, count uint64) (EsdbReadStream, error)
+	SetStreamMetadata(ctx context.Context, streamId string, opts esdb.AppendToStreamOptions, metadata esdb.StreamMetadata) (*esdb.WriteResult, error)
+}
+
+// EsdbReadStream is the result of a read from EventStoreDB, Recv returns io.EOF once every event was received.
+type EsdbReadStream interface {
+	Recv() (*esdb.ResolvedEvent, error)
+	Close()
+}
+
+// esdbClient adapts *esdb.Client to EsdbClient.
+type esdbClient struct {
+	db *esdb.Client
+}
+
+func NewEsdbClient(db *esdb.Client) *esdbClient {
+	return &esdbClient{db: db}
+}
+
+func (c *esdbClient) AppendToStream(ctx context.Context, streamId string, opts esdb.AppendToStreamOptions, events ...esdb.EventData) (*esdb.WriteResult, error) {
+	return c.db.AppendToStream(ctx, streamId, opts, events...)
+}
+
+func (c *esdbClient) SetStreamMetadata(ctx context.Context, streamId string, opts esdb.AppendToStreamOptions, metadata esdb.StreamMetadata) (*esdb.WriteResult, error) {
+	return c.db.SetStreamMetadata(ctx, streamId, opts, metadata)
+}
+
+func (c *esdbClient) ReadStream(ctx context.Context, streamId string, opts esdb.ReadStreamOptions, count uint64) (EsdbReadStream, error) {
+	stream, err := c.db.ReadStream(ctx, streamId, opts, count)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *esdbClient) ReadAll(ctx context.Context, opts esdb.ReadAllOptions, count uint64) (EsdbReadStream, error) {
+	stream, err := c.db.ReadAll(ctx, opts, count)
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
@@ -0,0 +1,46 @@
+//go:build !windows
+
+package eventstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// lockDir takes an exclusive flock on the lock file at path and records the pid of the process in it. the lock is
+// released by the kernel when the file is closed or the process exits, so a crashed process never leaves it behind.
+// the lock file itself is never removed, removing it would let two processes lock different files.
+func lockDir(dir, path string) (*os.File, error) {
+	lock, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		defer lock.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			holder, _ := io.ReadAll(lock)
+			return nil, fmt.Errorf("%w {%s}: held by pid {%s}", ErrStoreLocked, dir, strings.TrimSpace(string(holder)))
+		}
+		return nil, err
+	}
+
+	if err = lock.Truncate(0); err == nil {
+		_, err = lock.WriteAt([]byte(strconv.Itoa(os.Getpid())), 0)
+	}
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	return lock, nil
+}
+
+// unlockDir releases the lock taken by lockDir.
+func unlockDir(lock *os.File) error {
+	return lock.Close()
+}
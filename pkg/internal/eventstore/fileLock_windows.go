@@ -0,0 +1,49 @@
+package eventstore
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// lockDir creates the lock file at path and records the pid of the process in it. a lock file left behind by a
+// process that is no longer running is stale, it is removed and the lock is taken again.
+func lockDir(dir, path string) (*os.File, error) {
+	lock, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		holder, _ := os.ReadFile(path)
+		pid, parseErr := strconv.Atoi(strings.TrimSpace(string(holder)))
+		// on windows FindProcess fails for processes that aren't running.
+		if _, findErr := os.FindProcess(pid); parseErr == nil && findErr == nil {
+			return nil, fmt.Errorf("%w {%s}: held by pid {%d}", ErrStoreLocked, dir, pid)
+		}
+		if err = os.Remove(path); err != nil {
+			return nil, err
+		}
+		lock, err = os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	}
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("%w {%s}", ErrStoreLocked, dir)
+		}
+		return nil, err
+	}
+
+	if _, err = lock.WriteString(strconv.Itoa(os.Getpid())); err != nil {
+		lock.Close()
+		os.Remove(path)
+		return nil, err
+	}
+	return lock, nil
+}
+
+// unlockDir releases the lock taken by lockDir.
+func unlockDir(lock *os.File) error {
+	path := lock.Name()
+	if err := lock.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,664 @@
+package eventstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+const (
+	streamFileExt = ".jsonl"
+	lockFileName  = ".lock"
+	// the pattern of the temporary files and directories writes are staged in before they are renamed into place.
+	stagingPattern = ".staging-*"
+)
+
+// fileRecord is the on disk representation of an event. a stream file holds one record per line.
+type fileRecord struct {
+	EventId       string               `json:"eventId"`
+	EventType     events.EventType     `json:"eventType"`
+	AggregateType events.AggregateType `json:"aggregateType,omitempty"`
+	AggregateId   string               `json:"aggregateId"`
+	Version       int64                `json:"version"`
+	Timestamp     time.Time            `json:"timestamp"`
+	Data          json.RawMessage      `json:"data,omitempty"`
+	Metadata      json.RawMessage      `json:"metadata,omitempty"`
+	Position      uint64               `json:"position"`
+}
+
+func recordFromEvent(event events.Event) fileRecord {
+	return fileRecord{
+		EventId:       event.GetEventId(),
+		EventType:     event.GetEventType(),
+		AggregateType: event.GetAggregateType(),
+		AggregateId:   event.GetAggregateId(),
+		Version:       event.GetVersion(),
+		Timestamp:     event.GetTimestamp(),
+		Data:          event.GetData(),
+		Metadata:      event.GetMetadata(),
+		Position:      event.GetPosition(),
+	}
+}
+
+func (r fileRecord) toEvent() events.Event {
+	return events.Event{
+		EventId:       r.EventId,
+		EventType:     r.EventType,
+		Data:          r.Data,
+		Timestamp:     r.Timestamp,
+		AggregateType: r.AggregateType,
+		AggregateId:   r.AggregateId,
+		Version:       r.Version,
+		Metadata:      r.Metadata,
+		Position:      r.Position,
+	}
+}
+
+// fileStore is an implementation of events.AggregateStore and events.EventStore that persists every stream
+// as a JSON Lines file within a design directory. the store holds a lock on the directory until it is closed
+// so that no other process can write to the same design, the lock is released if the process crashes.
+type fileStore struct {
+	mu  sync.RWMutex
+	log logger.Logger
+	// the design directory the streams are persisted to.
+	dir string
+	// the lock file held by this store, nil once the store is closed.
+	lock *os.File
+	// the current version of each stream in the directory, indexed by the stream's id.
+	versions map[string]int64
+	// the position of the last event appended to any stream in the directory.
+	position uint64
+	// the position of the last event of each stream, ReadAll only reads the streams appended to after its position.
+	positions map[string]uint64
+	// the number of events each bounded stream is limited to, indexed by the stream's id.
+	maxCounts map[string]int
+}
+
+// NewFileStore opens the design directory at dir, creating it if it does not exist, and locks it for writing.
+// any stream found with a torn trailing write (i.e. a partially written final line) is truncated back to its
+// last complete event.
+func NewFileStore(log logger.Logger, dir string) (*fileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	lock, err := lockDir(dir, filepath.Join(dir, lockFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &fileStore{
+		log:       log,
+		dir:       dir,
+		lock:      lock,
+		versions:  make(map[string]int64),
+		positions: make(map[string]uint64),
+		maxCounts: make(map[string]int),
+	}
+
+	if err = s.open(); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close releases the lock held on the design directory.
+func (s *fileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lock == nil {
+		return nil
+	}
+
+	if err := unlockDir(s.lock); err != nil {
+		return err
+	}
+	s.lock = nil
+	return nil
+}
+
+func (s *fileStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	evts, err := s.LoadEvents(ctx, aggregate.GetId())
+	if err != nil {
+		return err
+	}
+
+	for _, evt := range evts {
+		if err = aggregate.RaiseEvent(evt); err != nil {
+			return fmt.Errorf("RaiseEvent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *fileStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	uncommitted := aggregate.GetUncommittedEvents()
+	if len(uncommitted) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lock == nil {
+		return ErrStoreClosed
+	}
+
+	current, ok := s.versions[aggregate.GetId()]
+	if !ok {
+		current = -1
+	}
+	expected := aggregate.GetExpectedVersion()
+	if current != expected {
+		if expected < 0 {
+			return fmt.Errorf("%w {%s}", events.ErrAlreadyExists, aggregate.GetId())
+		}
+		return fmt.Errorf("%w: stream {%s} is at version {%d}, expected version {%d}", events.ErrConcurrencyConflict, aggregate.GetId(), current, expected)
+	}
+
+	if err := s.append(aggregate.GetId(), uncommitted); err != nil {
+		return err
+	}
+
+	aggregate.ToSnapshot()
+	return nil
+}
+
+func (s *fileStore) Exists(ctx context.Context, streamId string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.versions[streamId]; !ok {
+		return fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+	return nil
+}
+
+func (s *fileStore) SaveEvents(ctx context.Context, evts []events.Event) error {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	streamId := evts[0].GetAggregateId()
+	for i := range evts {
+		if evts[i].GetAggregateId() != streamId {
+			return fmt.Errorf("%w: events for {%s} and {%s} cannot be saved together", events.ErrInvalidAggregateId, streamId, evts[i].GetAggregateId())
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lock == nil {
+		return ErrStoreClosed
+	}
+
+	// like EventStoreDB, the store assigns the version of events appended without an expected version.
+	next := int64(0)
+	if current, ok := s.versions[streamId]; ok {
+		next = current + 1
+	}
+	appended := make([]events.Event, len(evts))
+	for i, evt := range evts {
+		evt.SetVersion(next + int64(i))
+		appended[i] = evt
+	}
+
+	return s.append(streamId, appended)
+}
+
+func (s *fileStore) LoadEvents(ctx context.Context, streamId string) ([]events.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.versions[streamId]; !ok {
+		return nil, fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+
+	f, err := os.Open(s.streamPath(streamId))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readRecords(f)
+}
+
+func (s *fileStore) LoadEventsFrom(ctx context.Context, streamId string, from int64) ([]events.Event, error) {
+	evts, err := s.LoadEvents(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsFrom(evts, from), nil
+}
+
+func (s *fileStore) LoadLastEvent(ctx context.Context, streamId string) (events.Event, error) {
+	evts, err := s.LoadEvents(ctx, streamId)
+	if err != nil {
+		return events.Event{}, err
+	}
+
+	return evts[len(evts)-1], nil
+}
+
+// SetMaxCount bounds the stream for as long as the store is open, its file is rewritten whenever it holds more than
+// maxCount events.
+func (s *fileStore) SetMaxCount(ctx context.Context, streamId string, maxCount int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lock == nil {
+		return ErrStoreClosed
+	}
+
+	s.maxCounts[streamId] = maxCount
+	return s.trim(streamId)
+}
+
+// ReadAll loads up to count events, across all streams, appended after the passed position.
+func (s *fileStore) ReadAll(ctx context.Context, after events.LogPosition, count int) ([]events.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	all := make([]events.Event, 0)
+	for streamId, position := range s.positions {
+		if position <= after.Commit {
+			continue
+		}
+		f, err := os.Open(s.streamPath(streamId))
+		if err != nil {
+			return nil, err
+		}
+		evts, err := readRecords(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, evt := range evts {
+			if evt.GetPosition() > after.Commit {
+				all = append(all, evt)
+			}
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].GetPosition() < all[j].GetPosition()
+	})
+	if count > 0 && len(all) > count {
+		all = all[:count]
+	}
+	return all, nil
+}
+
+// Export writes every stream in the design directory to w as JSON Lines, ordered by stream id and then version.
+// the output can be read back into another store using Import.
+func (s *fileStore) Export(ctx context.Context, w io.Writer) error {
+	s.mu.RLock()
+	streamIds := make([]string, 0, len(s.versions))
+	for streamId := range s.versions {
+		streamIds = append(streamIds, streamId)
+	}
+	s.mu.RUnlock()
+	sort.Strings(streamIds)
+
+	for _, streamId := range streamIds {
+		evts, err := s.LoadEvents(ctx, streamId)
+		if err != nil {
+			return err
+		}
+
+		lines, err := encodeRecords(evts)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write(lines); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Import reads events written by Export from r and appends them to their streams. the events of a stream must
+// continue on from the stream's current version. nothing is written if any of the imported events are invalid.
+// the imported streams are written to a temporary directory first and then renamed over the streams of the
+// design, so an I/O error while writing them leaves the design untouched.
+func (s *fileStore) Import(ctx context.Context, r io.Reader) error {
+	evts, err := readRecords(r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lock == nil {
+		return ErrStoreClosed
+	}
+
+	streamIds := make([]string, 0)
+	streams := make(map[string][]events.Event)
+	next := make(map[string]int64)
+	for _, evt := range evts {
+		streamId := evt.GetAggregateId()
+		if _, ok := streams[streamId]; !ok {
+			streamIds = append(streamIds, streamId)
+			next[streamId] = 0
+			if current, ok := s.versions[streamId]; ok {
+				next[streamId] = current + 1
+			}
+		}
+
+		if evt.GetVersion() != next[streamId] {
+			return fmt.Errorf("%w: stream {%s} expected version {%d}, got {%d}", events.ErrInvalidEventVersion, streamId, next[streamId], evt.GetVersion())
+		}
+		next[streamId]++
+		streams[streamId] = append(streams[streamId], evt)
+	}
+
+	staging, err := os.MkdirTemp(s.dir, stagingPattern)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	position := s.position
+	positions := make(map[string]uint64, len(streamIds))
+	for _, streamId := range streamIds {
+		imported := positioned(streams[streamId], position)
+		if err = s.stage(staging, streamId, imported); err != nil {
+			return err
+		}
+		position += uint64(len(imported))
+		positions[streamId] = position
+	}
+
+	// renames within a directory are atomic, the import is only partial if the process crashes between them.
+	for _, streamId := range streamIds {
+		if err = os.Rename(filepath.Join(staging, filepath.Base(s.streamPath(streamId))), s.streamPath(streamId)); err != nil {
+			return err
+		}
+		s.versions[streamId] = next[streamId] - 1
+		s.positions[streamId] = positions[streamId]
+	}
+	s.position = position
+	if err = syncDir(s.dir); err != nil {
+		return err
+	}
+
+	s.log.Infof("imported %d events into %d streams", len(evts), len(streamIds))
+	return nil
+}
+
+// stage writes a copy of the stream's file followed by the passed events to the staging directory and syncs it to
+// disk.
+func (s *fileStore) stage(staging, streamId string, evts []events.Event) error {
+	lines, err := encodeRecords(evts)
+	if err != nil {
+		return err
+	}
+
+	existing, err := os.ReadFile(s.streamPath(streamId))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	f, err := os.OpenFile(filepath.Join(staging, filepath.Base(s.streamPath(streamId))), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(existing, lines...)); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// open indexes the streams in the design directory and repairs any torn trailing writes.
+func (s *fileStore) open() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if matched, _ := filepath.Match(stagingPattern, entry.Name()); matched {
+			// left behind by a write that was interrupted before it was renamed into place.
+			if err = os.RemoveAll(filepath.Join(s.dir, entry.Name())); err != nil {
+				return err
+			}
+			continue
+		}
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), streamFileExt) {
+			continue
+		}
+
+		streamId, err := url.PathUnescape(strings.TrimSuffix(entry.Name(), streamFileExt))
+		if err != nil {
+			return fmt.Errorf("%w {%s}: %v", ErrCorruptStream, entry.Name(), err)
+		}
+
+		evts, err := s.repairStream(streamId)
+		if err != nil {
+			return err
+		}
+		if len(evts) > 0 {
+			s.versions[streamId] = streamVersion(evts)
+		}
+		for _, evt := range evts {
+			if evt.GetPosition() > s.positions[streamId] {
+				s.positions[streamId] = evt.GetPosition()
+			}
+			if evt.GetPosition() > s.position {
+				s.position = evt.GetPosition()
+			}
+		}
+	}
+
+	return nil
+}
+
+// repairStream truncates the stream file back to its last complete line and returns the events it holds.
+func (s *fileStore) repairStream(streamId string) ([]events.Event, error) {
+	path := s.streamPath(streamId)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	complete := bytes.LastIndexByte(content, '\n') + 1
+	if complete < len(content) {
+		s.log.Warnf("truncating torn write of %d bytes from stream {%s}", len(content)-complete, streamId)
+		if err = os.Truncate(path, int64(complete)); err != nil {
+			return nil, err
+		}
+		content = content[:complete]
+	}
+
+	evts, err := readRecords(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("stream {%s}: %w", streamId, err)
+	}
+
+	// a bounded stream starts at the first event it kept.
+	for i, evt := range evts {
+		if evt.GetAggregateId() != streamId || evt.GetVersion() != evts[0].GetVersion()+int64(i) {
+			return nil, fmt.Errorf("%w {%s}: unexpected event {%s} at line %d", ErrCorruptStream, streamId, evt.String(), i+1)
+		}
+	}
+
+	return evts, nil
+}
+
+// append writes the passed events to the end of the stream's file and syncs it to disk. if the write fails
+// the file is truncated back to its original size. the caller must hold the write lock.
+func (s *fileStore) append(streamId string, evts []events.Event) error {
+	lines, err := encodeRecords(positioned(evts, s.position))
+	if err != nil {
+		return err
+	}
+
+	path := s.streamPath(streamId)
+	_, statErr := os.Stat(path)
+	created := errors.Is(statErr, os.ErrNotExist)
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(lines); err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		if truncateErr := f.Truncate(info.Size()); truncateErr != nil {
+			s.log.Errorf("failed to truncate stream {%s} after failed write: %v", streamId, truncateErr)
+		}
+		return err
+	}
+
+	if created {
+		// sync the directory so that the new stream file's entry survives a crash.
+		if err = syncDir(s.dir); err != nil {
+			return err
+		}
+	}
+
+	s.versions[streamId] = evts[len(evts)-1].GetVersion()
+	s.position += uint64(len(evts))
+	s.positions[streamId] = s.position
+	return s.trim(streamId)
+}
+
+// trim rewrites the file of a bounded stream without the events before its last maxCount events. the caller must hold
+// the write lock.
+func (s *fileStore) trim(streamId string) error {
+	maxCount, ok := s.maxCounts[streamId]
+	if !ok {
+		return nil
+	}
+	if _, ok = s.versions[streamId]; !ok {
+		return nil
+	}
+
+	f, err := os.Open(s.streamPath(streamId))
+	if err != nil {
+		return err
+	}
+	evts, err := readRecords(f)
+	f.Close()
+	if err != nil || len(evts) <= maxCount {
+		return err
+	}
+
+	lines, err := encodeRecords(evts[len(evts)-maxCount:])
+	if err != nil {
+		return err
+	}
+
+	staged, err := os.CreateTemp(s.dir, stagingPattern)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(staged.Name())
+
+	if _, err = staged.Write(lines); err == nil {
+		err = staged.Sync()
+	}
+	if closeErr := staged.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(staged.Name(), s.streamPath(streamId)); err != nil {
+		return err
+	}
+	return syncDir(s.dir)
+}
+
+// positioned returns a copy of the events positioned after the passed position.
+func positioned(evts []events.Event, after uint64) []events.Event {
+	positioned := make([]events.Event, len(evts))
+	for i, evt := range evts {
+		evt.SetPosition(after + uint64(i) + 1)
+		positioned[i] = evt
+	}
+	return positioned
+}
+
+func (s *fileStore) streamPath(streamId string) string {
+	return filepath.Join(s.dir, url.PathEscape(streamId)+streamFileExt)
+}
+
+func encodeRecords(evts []events.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, evt := range evts {
+		if err := encoder.Encode(recordFromEvent(evt)); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func readRecords(r io.Reader) ([]events.Event, error) {
+	evts := make([]events.Event, 0)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record fileRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrCorruptStream, line, err)
+		}
+		evts = append(evts, record.toEvent())
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return evts, nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -0,0 +1,146 @@
+package eventstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+func TestFileStoreLock(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	if _, err = NewFileStore(logger.NewLogger("test"), dir); !errors.Is(err, ErrStoreLocked) {
+		t.Fatalf("expected %v, got %v", ErrStoreLocked, err)
+	}
+
+	if err = s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	reopened, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore after Close: %v", err)
+	}
+	reopened.Close()
+}
+
+func TestFileStoreStaleLock(t *testing.T) {
+	dir := t.TempDir()
+
+	// the lock file of a process that crashed without closing the store.
+	if err := os.WriteFile(filepath.Join(dir, lockFileName), []byte("999999"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("expected the stale lock to be taken over, got %v", err)
+	}
+	s.Close()
+}
+
+func TestFileStoreImport(t *testing.T) {
+	ctx := context.Background()
+
+	source, err := NewFileStore(logger.NewLogger("test"), t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer source.Close()
+	for _, id := range []string{"p1", "p2"} {
+		if err = source.Save(ctx, newPod(t, id)); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	var exported bytes.Buffer
+	if err = source.Export(ctx, &exported); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	dir := t.TempDir()
+	target, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer target.Close()
+
+	// p1 already exists in the target, so the import is rejected as a whole.
+	if err = target.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err = target.Import(ctx, bytes.NewReader(exported.Bytes())); !errors.Is(err, events.ErrInvalidEventVersion) {
+		t.Fatalf("expected %v, got %v", events.ErrInvalidEventVersion, err)
+	}
+	if err = target.Exists(ctx, newPod(t, "p2").GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("expected nothing to be imported, got %v", err)
+	}
+
+	empty, err := NewFileStore(logger.NewLogger("test"), t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer empty.Close()
+	if err = empty.Import(ctx, bytes.NewReader(exported.Bytes())); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	evts, err := empty.ReadAll(ctx, events.LogPosition{}, 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(evts) != 2 || evts[0].GetPosition() != 1 || evts[1].GetPosition() != 2 {
+		t.Fatalf("expected 2 events at positions 1 and 2, got %v", evts)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			t.Fatalf("staging directory {%s} left behind", entry.Name())
+		}
+	}
+}
+
+func TestFileStoreReadAllAfter(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	for _, id := range []string{"p1", "p2", "p3"} {
+		if err = s.Save(ctx, newPod(t, id)); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	s.Close()
+
+	reopened, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer reopened.Close()
+
+	// the streams with no event after the position aren't read.
+	if err = os.WriteFile(reopened.streamPath(newPod(t, "p1").GetId()), []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	evts, err := reopened.ReadAll(ctx, events.NewLogPosition(1), 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if len(evts) != 2 || evts[0].GetPosition() != 2 || evts[1].GetPosition() != 3 {
+		t.Fatalf("expected 2 events at positions 2 and 3, got %v", evts)
+	}
+}
@@ -0,0 +1,197 @@
+package eventstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// memoryStore is an in-process implementation of events.AggregateStore and events.EventStore.
+// it is intended for tests and local prototyping, everything it holds is lost once the process exits.
+type memoryStore struct {
+	mu sync.RWMutex
+	// the events of each stream, indexed by the stream's id.
+	streams map[string][]events.Event
+	// every event saved to the store, in the order they were saved.
+	all []events.Event
+	// the number of events each bounded stream is limited to, indexed by the stream's id.
+	maxCounts map[string]int
+}
+
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{
+		streams:   make(map[string][]events.Event),
+		all:       make([]events.Event, 0),
+		maxCounts: make(map[string]int),
+	}
+}
+
+func (s *memoryStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	evts, err := s.LoadEvents(ctx, aggregate.GetId())
+	if err != nil {
+		return err
+	}
+
+	for _, evt := range evts {
+		if err = aggregate.RaiseEvent(evt); err != nil {
+			return fmt.Errorf("RaiseEvent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	uncommitted := aggregate.GetUncommittedEvents()
+	if len(uncommitted) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current := streamVersion(s.streams[aggregate.GetId()])
+	expected := aggregate.GetExpectedVersion()
+	if current != expected {
+		if expected < 0 {
+			return fmt.Errorf("%w {%s}", events.ErrAlreadyExists, aggregate.GetId())
+		}
+		return fmt.Errorf("%w: stream {%s} is at version {%d}, expected version {%d}", events.ErrConcurrencyConflict, aggregate.GetId(), current, expected)
+	}
+
+	s.append(aggregate.GetId(), uncommitted)
+	aggregate.ToSnapshot()
+	return nil
+}
+
+func (s *memoryStore) Exists(ctx context.Context, streamId string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, ok := s.streams[streamId]; !ok {
+		return fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+	return nil
+}
+
+func (s *memoryStore) SaveEvents(ctx context.Context, evts []events.Event) error {
+	if len(evts) == 0 {
+		return nil
+	}
+
+	streamId := evts[0].GetAggregateId()
+	for i := range evts {
+		if evts[i].GetAggregateId() != streamId {
+			return fmt.Errorf("%w: events for {%s} and {%s} cannot be saved together", events.ErrInvalidAggregateId, streamId, evts[i].GetAggregateId())
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// like EventStoreDB, the store assigns the version of events appended without an expected version.
+	next := streamVersion(s.streams[streamId]) + 1
+	appended := make([]events.Event, len(evts))
+	for i, evt := range evts {
+		evt.SetVersion(next + int64(i))
+		appended[i] = evt
+	}
+
+	s.append(streamId, appended)
+	return nil
+}
+
+func (s *memoryStore) LoadEvents(ctx context.Context, streamId string) ([]events.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stream, ok := s.streams[streamId]
+	if !ok {
+		return nil, fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+
+	evts := make([]events.Event, len(stream))
+	copy(evts, stream)
+	return evts, nil
+}
+
+func (s *memoryStore) LoadEventsFrom(ctx context.Context, streamId string, from int64) ([]events.Event, error) {
+	evts, err := s.LoadEvents(ctx, streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsFrom(evts, from), nil
+}
+
+func (s *memoryStore) LoadLastEvent(ctx context.Context, streamId string) (events.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stream, ok := s.streams[streamId]
+	if !ok {
+		return events.Event{}, fmt.Errorf("%w {%s}", events.ErrAggregateNotFound, streamId)
+	}
+	return stream[len(stream)-1], nil
+}
+
+func (s *memoryStore) SetMaxCount(ctx context.Context, streamId string, maxCount int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maxCounts[streamId] = maxCount
+	s.trim(streamId)
+	return nil
+}
+
+// AllEvents returns every event saved to the store, across all streams, in the order they were saved.
+func (s *memoryStore) AllEvents() []events.Event {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	evts := make([]events.Event, len(s.all))
+	copy(evts, s.all)
+	return evts
+}
+
+// ReadAll loads up to count events, across all streams, saved after the passed position. the position of an event
+// is its 1 based index within the log of all events.
+func (s *memoryStore) ReadAll(ctx context.Context, from events.LogPosition, count int) ([]events.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	after := from.Commit
+	if after >= uint64(len(s.all)) {
+		return []events.Event{}, nil
+	}
+
+	end := len(s.all)
+	if count > 0 && int(after)+count < end {
+		end = int(after) + count
+	}
+
+	evts := make([]events.Event, end-int(after))
+	copy(evts, s.all[after:end])
+	return evts, nil
+}
+
+// append adds the passed events to the end of the stream. the caller must hold the write lock.
+func (s *memoryStore) append(streamId string, evts []events.Event) {
+	for _, evt := range evts {
+		evt.SetPosition(uint64(len(s.all)) + 1)
+		s.streams[streamId] = append(s.streams[streamId], evt)
+		s.all = append(s.all, evt)
+	}
+	s.trim(streamId)
+}
+
+// trim discards the events of a bounded stream before its last maxCount events. they are kept in the log of all
+// events. the caller must hold the write lock.
+func (s *memoryStore) trim(streamId string) {
+	stream := s.streams[streamId]
+	if maxCount, ok := s.maxCounts[streamId]; ok && len(stream) > maxCount {
+		s.streams[streamId] = append([]events.Event(nil), stream[len(stream)-maxCount:]...)
+	}
+}
@@ -0,0 +1,146 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+func TestMemoryStoreLoadNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	pod := podAggregate.NewPodAggregateWithId("p1")
+	if err := store.Load(ctx, pod); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("Load: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+	if err := store.Exists(ctx, pod.GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("Exists: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+	if _, err := store.LoadEvents(ctx, pod.GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("LoadEvents: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+	if _, err := store.LoadLastEvent(ctx, pod.GetId()); !errors.Is(err, events.ErrAggregateNotFound) {
+		t.Fatalf("LoadLastEvent: expected %v, got %v", events.ErrAggregateNotFound, err)
+	}
+}
+
+func TestMemoryStoreAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	if err := store.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save(ctx, newPod(t, "p1")); !errors.Is(err, events.ErrAlreadyExists) {
+		t.Fatalf("expected %v, got %v", events.ErrAlreadyExists, err)
+	}
+	if err := store.Exists(ctx, newPod(t, "p1").GetId()); err != nil {
+		t.Fatalf("Exists: %v", err)
+	}
+}
+
+func TestMemoryStoreConcurrencyConflict(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.Save(ctx, newPod(t, "p1")); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// both are loaded at version 0, the second is saved after the first has moved the stream on.
+	loaded := make([]*podAggregate.PodAggregate, 2)
+	for i := range loaded {
+		loaded[i] = podAggregate.NewPodAggregateWithId("p1")
+		if err := store.Load(ctx, loaded[i]); err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		if err := loaded[i].DeletePod(ctx, fmt.Sprintf("reason %d", i)); err != nil {
+			t.Fatalf("DeletePod: %v", err)
+		}
+	}
+	if err := store.Save(ctx, loaded[0]); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Save(ctx, loaded[1]); !errors.Is(err, events.ErrConcurrencyConflict) {
+		t.Fatalf("expected %v, got %v", events.ErrConcurrencyConflict, err)
+	}
+
+	evts, err := store.LoadEvents(ctx, loaded[0].GetId())
+	if err != nil {
+		t.Fatalf("LoadEvents: %v", err)
+	}
+	if len(evts) != 2 || evts[1].GetVersion() != 1 {
+		t.Fatalf("expected the stream at version 1 after the first save only, got %d events", len(evts))
+	}
+
+	// reloaded, the aggregate is at the version of the stream.
+	reloaded := podAggregate.NewPodAggregateWithId("p1")
+	if err = store.Load(ctx, reloaded); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if reloaded.GetVersion() != 1 || !reloaded.IsDeleted() {
+		t.Fatalf("expected the deleted pod at version 1, got version %d", reloaded.GetVersion())
+	}
+}
+
+func TestMemoryStoreReadAll(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	// the events of p1 and p2 are interleaved in the log of all events.
+	p1 := newPod(t, "p1")
+	for _, pod := range []*podAggregate.PodAggregate{p1, newPod(t, "p2")} {
+		if err := store.Save(ctx, pod); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	if err := p1.DeletePod(ctx, "unused"); err != nil {
+		t.Fatalf("DeletePod: %v", err)
+	}
+	if err := store.Save(ctx, p1); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	evts, err := store.ReadAll(ctx, events.LogPosition{}, 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	expected := []string{"pod-p1", "pod-p2", "pod-p1"}
+	if len(evts) != len(expected) {
+		t.Fatalf("expected %d events, got %d", len(expected), len(evts))
+	}
+	for i, evt := range evts {
+		if evt.GetPosition() != uint64(i+1) || evt.GetAggregateId() != expected[i] {
+			t.Fatalf("expected {%s} at position %d, got {%s} at %d", expected[i], i+1, evt.GetAggregateId(), evt.GetPosition())
+		}
+	}
+
+	tests := []struct {
+		after     uint64
+		count     int
+		positions []uint64
+	}{
+		{after: 1, positions: []uint64{2, 3}},
+		{after: 0, count: 2, positions: []uint64{1, 2}},
+		{after: 1, count: 1, positions: []uint64{2}},
+		{after: 3},
+		{after: 10},
+	}
+	for _, test := range tests {
+		evts, err = store.ReadAll(ctx, events.NewLogPosition(test.after), test.count)
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+		positions := make([]uint64, 0, len(evts))
+		for _, evt := range evts {
+			positions = append(positions, evt.GetPosition())
+		}
+		if fmt.Sprint(positions) != fmt.Sprint(test.positions) {
+			t.Fatalf("reading %d after %d: expected the positions %v, got %v", test.count, test.after, test.positions, positions)
+		}
+	}
+}
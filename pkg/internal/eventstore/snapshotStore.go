@@ -0,0 +1,213 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// SnapshotTaken is the event type of the events that hold snapshots within a snapshot stream.
+	SnapshotTaken events.EventType = "SNAPSHOT_TAKEN"
+
+	snapshotStreamPrefix = "snapshot-"
+
+	defaultSnapshotFrequency = 100
+
+	// the number of snapshots kept in a snapshot stream, the older ones are discarded.
+	snapshotsKept = 1
+)
+
+// streamSnapshotStore is an implementation of events.SnapshotStore that saves the snapshots of an aggregate as
+// events in a dedicated snapshot stream of an events.EventStore. the latest event in the stream is the current snapshot,
+// the stream is bounded to the last snapshotsKept snapshots.
+type streamSnapshotStore struct {
+	store events.EventStore
+
+	mu sync.Mutex
+	// the snapshot streams this store has bounded, indexed by the stream's id.
+	bounded map[string]bool
+}
+
+func NewStreamSnapshotStore(store events.EventStore) *streamSnapshotStore {
+	return &streamSnapshotStore{store: store, bounded: make(map[string]bool)}
+}
+
+// SnapshotStreamId returns the id of the stream that holds the snapshots of the aggregate with the passed id.
+func SnapshotStreamId(aggregateId string) string {
+	return snapshotStreamPrefix + aggregateId
+}
+
+func (s *streamSnapshotStore) SaveSnapshot(ctx context.Context, snapshot events.Snapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	event := events.Event{
+		EventId:     uuid.NewV4().String(),
+		EventType:   SnapshotTaken,
+		Data:        data,
+		Timestamp:   snapshot.Timestamp,
+		AggregateId: SnapshotStreamId(snapshot.AggregateId),
+	}
+	if err = s.store.SaveEvents(ctx, []events.Event{event}); err != nil {
+		return err
+	}
+
+	return s.bound(ctx, event.AggregateId)
+}
+
+// bound bounds the snapshot stream the first time a snapshot is saved to it by this store.
+func (s *streamSnapshotStore) bound(ctx context.Context, streamId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bounded[streamId] {
+		return nil
+	}
+	if err := s.store.SetMaxCount(ctx, streamId, snapshotsKept); err != nil {
+		return err
+	}
+	s.bounded[streamId] = true
+	return nil
+}
+
+func (s *streamSnapshotStore) LoadSnapshot(ctx context.Context, aggregateId string) (events.Snapshot, error) {
+	latest, err := s.store.LoadLastEvent(ctx, SnapshotStreamId(aggregateId))
+	if err != nil {
+		if errors.Is(err, events.ErrAggregateNotFound) {
+			return events.Snapshot{}, fmt.Errorf("%w {%s}", events.ErrSnapshotNotFound, aggregateId)
+		}
+		return events.Snapshot{}, err
+	}
+
+	var snapshot events.Snapshot
+	if err = latest.GetJsonData(&snapshot); err != nil {
+		return events.Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// eventSourcedStore is a store that can both save/load aggregates and load the raw events of their streams.
+type eventSourcedStore interface {
+	events.AggregateStore
+	events.EventStore
+}
+
+// snapshotAggregateStore is an events.AggregateStore that bounds the cost of loading aggregates by restoring them from
+// their latest snapshot and replaying only the events that followed it. a snapshot is taken every frequency events
+// for each aggregate type that has a registered events.SnapshotSerializer, all other aggregate types are loaded from
+// their full stream.
+type snapshotAggregateStore struct {
+	log         logger.Logger
+	store       eventSourcedStore
+	snapshots   events.SnapshotStore
+	serializers map[events.AggregateType]events.SnapshotSerializer
+	frequency   int64
+}
+
+// NewSnapshotAggregateStore wraps the passed store with snapshot support. a frequency of 0 or less uses the default
+// frequency of a snapshot every 100 events.
+func NewSnapshotAggregateStore(log logger.Logger, store eventSourcedStore, snapshots events.SnapshotStore, frequency int64, serializers ...events.SnapshotSerializer) *snapshotAggregateStore {
+	if frequency <= 0 {
+		frequency = defaultSnapshotFrequency
+	}
+
+	s := &snapshotAggregateStore{
+		log:         log,
+		store:       store,
+		snapshots:   snapshots,
+		serializers: make(map[events.AggregateType]events.SnapshotSerializer),
+		frequency:   frequency,
+	}
+	for _, serializer := range serializers {
+		s.serializers[serializer.AggregateType()] = serializer
+	}
+	return s
+}
+
+func (s *snapshotAggregateStore) Load(ctx context.Context, aggregate events.Aggregate) error {
+	serializer, ok := s.serializers[aggregate.GetType()]
+	if !ok {
+		return s.store.Load(ctx, aggregate)
+	}
+
+	snapshot, err := s.snapshots.LoadSnapshot(ctx, aggregate.GetId())
+	switch {
+	case errors.Is(err, events.ErrSnapshotNotFound):
+		return s.store.Load(ctx, aggregate)
+	case err != nil:
+		// snapshots can always be rebuilt, so a snapshot that can't be read shouldn't prevent the aggregate from loading.
+		s.log.Warnf("failed to load snapshot of {%s}, loading from events: %v", aggregate.GetId(), err)
+		return s.store.Load(ctx, aggregate)
+	case snapshot.SchemaVersion != serializer.SchemaVersion():
+		s.log.Debugf("discarding snapshot of {%s} with schema version %d, current schema version %d", aggregate.GetId(), snapshot.SchemaVersion, serializer.SchemaVersion())
+		return s.store.Load(ctx, aggregate)
+	}
+
+	if err = serializer.Deserialize(aggregate, snapshot.State); err != nil {
+		return fmt.Errorf("Deserialize: %w", err)
+	}
+	aggregate.RestoreVersion(snapshot.Version)
+
+	evts, err := s.store.LoadEventsFrom(ctx, aggregate.GetId(), snapshot.Version+1)
+	if err != nil {
+		return err
+	}
+
+	for _, evt := range evts {
+		if err = aggregate.RaiseEvent(evt); err != nil {
+			return fmt.Errorf("RaiseEvent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *snapshotAggregateStore) Save(ctx context.Context, aggregate events.Aggregate) error {
+	before := aggregate.GetExpectedVersion()
+	if err := s.store.Save(ctx, aggregate); err != nil {
+		return err
+	}
+
+	if _, ok := s.serializers[aggregate.GetType()]; !ok {
+		return nil
+	}
+
+	// versions are zero based, so the number of events in the stream is always version+1.
+	if (aggregate.GetVersion()+1)/s.frequency > (before+1)/s.frequency {
+		if err := s.TakeSnapshot(ctx, aggregate); err != nil {
+			// the events have been saved, failing to snapshot them only means the next load is slower.
+			s.log.Warnf("failed to snapshot {%s}: %v", aggregate.GetId(), err)
+		}
+	}
+
+	return nil
+}
+
+func (s *snapshotAggregateStore) Exists(ctx context.Context, streamId string) error {
+	return s.store.Exists(ctx, streamId)
+}
+
+// TakeSnapshot saves a snapshot of the current state of the aggregate. it can be used to rebuild a snapshot that
+// was discarded after a schema change without waiting for the next scheduled snapshot.
+func (s *snapshotAggregateStore) TakeSnapshot(ctx context.Context, aggregate events.Aggregate) error {
+	serializer, ok := s.serializers[aggregate.GetType()]
+	if !ok {
+		return fmt.Errorf("%w: no snapshot serializer registered for aggregate type {%s}", events.ErrInvalidAggregate, aggregate.GetType())
+	}
+
+	state, err := serializer.Serialize(aggregate)
+	if err != nil {
+		return fmt.Errorf("Serialize: %w", err)
+	}
+
+	return s.snapshots.SaveSnapshot(ctx, events.NewSnapshot(aggregate, serializer.SchemaVersion(), state))
+}
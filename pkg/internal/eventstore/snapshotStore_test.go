@@ -0,0 +1,141 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+func TestStreamSnapshotStore(t *testing.T) {
+	dir := t.TempDir()
+	fileStore, err := NewFileStore(logger.NewLogger("test"), dir)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer func() { fileStore.Close() }()
+
+	stores := map[string]events.EventStore{
+		"memory": NewMemoryStore(),
+		"file":   fileStore,
+		"esdb":   NewEsdbStore(logger.NewLogger("test"), newFakeEsdbClient()),
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			snapshots := NewStreamSnapshotStore(store)
+
+			if _, err := snapshots.LoadSnapshot(ctx, "pod-p1"); !errors.Is(err, events.ErrSnapshotNotFound) {
+				t.Fatalf("expected %v, got %v", events.ErrSnapshotNotFound, err)
+			}
+
+			for version := int64(99); version < 400; version += 100 {
+				snapshot := events.Snapshot{AggregateId: "pod-p1", Version: version, State: json.RawMessage(`{}`)}
+				if err := snapshots.SaveSnapshot(ctx, snapshot); err != nil {
+					t.Fatalf("SaveSnapshot: %v", err)
+				}
+			}
+
+			snapshot, err := snapshots.LoadSnapshot(ctx, "pod-p1")
+			if err != nil {
+				t.Fatalf("LoadSnapshot: %v", err)
+			}
+			if snapshot.Version != 399 {
+				t.Fatalf("expected the snapshot at version 399, got version %d", snapshot.Version)
+			}
+
+			evts, err := store.LoadEvents(ctx, SnapshotStreamId("pod-p1"))
+			if err != nil {
+				t.Fatalf("LoadEvents: %v", err)
+			}
+			if len(evts) != snapshotsKept || evts[0].GetVersion() != 3 {
+				t.Fatalf("expected the snapshot stream to hold its last event at version 3, got %v", evts)
+			}
+		})
+	}
+
+	// the trimmed stream no longer starts at version 0.
+	if err = fileStore.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if fileStore, err = NewFileStore(logger.NewLogger("test"), dir); err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	if err = fileStore.SaveEvents(context.Background(), []events.Event{{EventType: SnapshotTaken, AggregateId: SnapshotStreamId("pod-p1")}}); err != nil {
+		t.Fatalf("SaveEvents: %v", err)
+	}
+	last, err := fileStore.LoadLastEvent(context.Background(), SnapshotStreamId("pod-p1"))
+	if err != nil {
+		t.Fatalf("LoadLastEvent: %v", err)
+	}
+	if last.GetVersion() != 4 {
+		t.Fatalf("expected the event to be appended at version 4, got %d", last.GetVersion())
+	}
+}
+
+func TestSnapshotAggregateStoreDiscardsStaleSnapshot(t *testing.T) {
+	ctx := context.Background()
+	memoryStore := NewMemoryStore()
+	snapshots := NewStreamSnapshotStore(memoryStore)
+	serializer := rackAggregate.NewSnapshotSerializer()
+	store := NewSnapshotAggregateStore(logger.NewLogger("test"), memoryStore, snapshots, 100, serializer)
+
+	rack := rackAggregate.NewRackAggregateWithId("r1")
+	if err := rack.CreateRack(ctx, "a01", 42, "dc1"); err != nil {
+		t.Fatalf("CreateRack: %v", err)
+	}
+	if err := rack.ReserveRUs(ctx, "40-42", "patching", []string{"network"}); err != nil {
+		t.Fatalf("ReserveRUs: %v", err)
+	}
+	if err := store.Save(ctx, rack); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// a snapshot of the rack under another name, so that it is known whether the rack was restored from it.
+	snapshotOf := func(schemaVersion int) events.Snapshot {
+		renamed := rackAggregate.NewRackAggregateWithId("r1")
+		if err := memoryStore.Load(ctx, renamed); err != nil {
+			t.Fatalf("Load: %v", err)
+		}
+		renamed.Rack.Name = "from-snapshot"
+		state, err := serializer.Serialize(renamed)
+		if err != nil {
+			t.Fatalf("Serialize: %v", err)
+		}
+		return events.NewSnapshot(renamed, schemaVersion, state)
+	}
+
+	tests := []struct {
+		name          string
+		schemaVersion int
+		expectedName  string
+	}{
+		{name: "current", schemaVersion: serializer.SchemaVersion(), expectedName: "from-snapshot"},
+		{name: "stale", schemaVersion: serializer.SchemaVersion() - 1, expectedName: "a01"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := snapshots.SaveSnapshot(ctx, snapshotOf(test.schemaVersion)); err != nil {
+				t.Fatalf("SaveSnapshot: %v", err)
+			}
+
+			loaded := rackAggregate.NewRackAggregateWithId("r1")
+			if err := store.Load(ctx, loaded); err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if loaded.Rack.Name != test.expectedName {
+				t.Fatalf("expected the rack to be named {%s}, got {%s}", test.expectedName, loaded.Rack.Name)
+			}
+			if loaded.GetVersion() != rack.GetVersion() {
+				t.Fatalf("expected the rack at version %d, got version %d", rack.GetVersion(), loaded.GetVersion())
+			}
+			if len(loaded.Rack.Reservations) != 1 {
+				t.Fatalf("expected the reservation to be restored, got %v", loaded.Rack.Reservations)
+			}
+		})
+	}
+}
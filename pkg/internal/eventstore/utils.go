@@ -0,0 +1,21 @@
+package eventstore
+
+import "github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+
+// streamVersion returns the version of the last event of a stream, or -1 if the stream is empty.
+func streamVersion(stream []events.Event) int64 {
+	if len(stream) == 0 {
+		return -1
+	}
+	return stream[len(stream)-1].GetVersion()
+}
+
+// eventsFrom returns the events of a stream, ordered by version, that have a version greater than or equal to from.
+func eventsFrom(stream []events.Event, from int64) []events.Event {
+	for i := range stream {
+		if stream[i].GetVersion() >= from {
+			return stream[i:]
+		}
+	}
+	return stream[len(stream):]
+}
@@ -0,0 +1,54 @@
+package filedb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+)
+
+type checkpoint struct {
+	Name     string `json:"name"`
+	Position uint64 `json:"position"`
+	// only set by stores that position events with a pair of numbers, see events.LogPosition.
+	PreparePosition uint64    `json:"preparePosition,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// checkpointStore is an implementation of projectors.CheckpointStore that keeps the checkpoint of every runner in its
+// own file.
+type checkpointStore struct {
+	collection *collection
+}
+
+func NewCheckpointStore(database *Database) (*checkpointStore, error) {
+	collection, err := database.collection(checkpointCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &checkpointStore{collection: collection}, nil
+}
+
+func (s *checkpointStore) LoadCheckpoint(ctx context.Context, name string) (events.LogPosition, error) {
+	var cp checkpoint
+	err := s.collection.get(name, &cp)
+	if errors.Is(err, errDocumentNotFound) {
+		return events.LogPosition{}, fmt.Errorf("%w {%s}", projectors.ErrCheckpointNotFound, name)
+	}
+	if err != nil {
+		return events.LogPosition{}, err
+	}
+	return events.LogPosition{Commit: cp.Position, Prepare: cp.PreparePosition}, nil
+}
+
+func (s *checkpointStore) SaveCheckpoint(ctx context.Context, name string, position events.LogPosition) error {
+	return s.collection.put(name, checkpoint{
+		Name:            name,
+		Position:        position.Commit,
+		PreparePosition: position.Prepare,
+		UpdatedAt:       time.Now(),
+	})
+}
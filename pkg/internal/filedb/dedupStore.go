@@ -0,0 +1,94 @@
+package filedb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/commands"
+)
+
+type processedCommand struct {
+	commands.ProcessedCommand
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// dedupStore is an implementation of commands.DedupStore that keeps the record of every processed command in its own
+// file, so a command replayed by a later dcgen process isn't handled again. expired records are deleted as they are
+// read, and swept as new records are put, at most once per sweep interval.
+type dedupStore struct {
+	collection *collection
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	// the minimum time between sweeps of expired records.
+	sweepInterval time.Duration
+}
+
+func NewDedupStore(database *Database) (*dedupStore, error) {
+	collection, err := database.collection(dedupCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &dedupStore{collection: collection, lastSweep: time.Now(), sweepInterval: time.Hour}, nil
+}
+
+func (s *dedupStore) Get(ctx context.Context, key string) (*commands.ProcessedCommand, error) {
+	var record processedCommand
+	err := s.collection.get(key, &record)
+	if errors.Is(err, errDocumentNotFound) {
+		return nil, fmt.Errorf("%w {%s}", commands.ErrCommandNotProcessed, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(record.ExpiresAt) {
+		if err = s.collection.delete(key); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w {%s}", commands.ErrCommandNotProcessed, key)
+	}
+	return &record.ProcessedCommand, nil
+}
+
+func (s *dedupStore) Put(ctx context.Context, record *commands.ProcessedCommand, ttl time.Duration) error {
+	now := time.Now()
+	if err := s.collection.put(record.Key, processedCommand{ProcessedCommand: *record, ExpiresAt: now.Add(ttl)}); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.lastSweep) < s.sweepInterval {
+		return nil
+	}
+	s.lastSweep = now
+	return s.sweep(now)
+}
+
+// sweep deletes the records that expired before now.
+func (s *dedupStore) sweep(now time.Time) error {
+	keys, err := s.collection.ids()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var record processedCommand
+		if err = s.collection.get(key, &record); err != nil {
+			// the record was deleted by a concurrent Get.
+			if errors.Is(err, errDocumentNotFound) {
+				continue
+			}
+			return err
+		}
+		if now.After(record.ExpiresAt) {
+			if err = s.collection.delete(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
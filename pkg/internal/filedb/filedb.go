@@ -0,0 +1,134 @@
+package filedb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	documentExt = ".json"
+
+	checkpointCollection = "checkpoint"
+	sagaCollection       = "saga"
+	dedupCollection      = "dedup"
+)
+
+// errDocumentNotFound is returned by a collection that holds no document with the requested id.
+var errDocumentNotFound = errors.New("document not found")
+
+// Database keeps documents as JSON files in a directory, one sub-directory per collection. it is meant for the state
+// a single dcgen process keeps next to its event store, e.g. in the design directory of the file store.
+type Database struct {
+	dir string
+}
+
+// NewDatabase opens the database in dir, creating the directory if it does not exist.
+func NewDatabase(dir string) (*Database, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Database{dir: dir}, nil
+}
+
+// collection is a directory holding one JSON document per id.
+type collection struct {
+	dir string
+}
+
+func (d *Database) collection(name string) (*collection, error) {
+	dir := filepath.Join(d.dir, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &collection{dir: dir}, nil
+}
+
+func (c *collection) path(id string) string {
+	return filepath.Join(c.dir, url.PathEscape(id)+documentExt)
+}
+
+// get decodes the document with the id into v, errDocumentNotFound is returned if there is none.
+func (c *collection) get(id string, v any) error {
+	data, err := os.ReadFile(c.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w {%s}", errDocumentNotFound, id)
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// put inserts the document or replaces the document with the same id. the document is written to a temporary file
+// that is renamed over the previous one, so a crash never leaves a partially written document behind.
+func (c *collection) put(id string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(c.dir, ".put-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path(id))
+}
+
+// ids returns the ids of every document of the collection.
+func (c *collection) ids() ([]string, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), documentExt) || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		id, err := url.PathUnescape(strings.TrimSuffix(entry.Name(), documentExt))
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// delete removes the document with the id, if there is one.
+func (c *collection) delete(id string) error {
+	if err := os.Remove(c.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// deleteAll removes every document of the collection.
+func (c *collection) deleteAll() error {
+	ids, err := c.ids()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = c.delete(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package filedb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/sagas"
+)
+
+// sagaStateStore is an implementation of sagas.StateStore that keeps every saga state in its own file.
+type sagaStateStore struct {
+	collection *collection
+}
+
+func NewSagaStateStore(database *Database) (*sagaStateStore, error) {
+	collection, err := database.collection(sagaCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &sagaStateStore{collection: collection}, nil
+}
+
+func (s *sagaStateStore) LoadState(ctx context.Context, id string) (*sagas.State, error) {
+	var state sagas.State
+	err := s.collection.get(id, &state)
+	if errors.Is(err, errDocumentNotFound) {
+		return nil, fmt.Errorf("%w {%s}", sagas.ErrStateNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (s *sagaStateStore) SaveState(ctx context.Context, state *sagas.State) error {
+	return s.collection.put(state.ID, state)
+}
+
+func (s *sagaStateStore) DeleteAll(ctx context.Context) error {
+	return s.collection.deleteAll()
+}
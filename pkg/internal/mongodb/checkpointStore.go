@@ -0,0 +1,60 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/projectors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type checkpoint struct {
+	Name     string `bson:"name"`
+	Position int64  `bson:"position"`
+	// only set by stores that position events with a pair of numbers, see events.LogPosition.
+	PreparePosition int64     `bson:"preparePosition,omitempty"`
+	UpdatedAt       time.Time `bson:"updatedAt"`
+}
+
+// checkpointStore is a MongoDB implementation of projectors.CheckpointStore. keeping the checkpoints in the same
+// database as the projections means they are lost together, which causes a full rebuild instead of a gap.
+type checkpointStore struct {
+	collection *mongo.Collection
+}
+
+// NewCheckpointStore creates the store and the indexes of its collection.
+func NewCheckpointStore(ctx context.Context, database *mongo.Database) (*checkpointStore, error) {
+	collection := database.Collection(checkpointCollection)
+	if err := ensureIndices(ctx, collection); err != nil {
+		return nil, err
+	}
+	return &checkpointStore{collection: collection}, nil
+}
+
+func (s *checkpointStore) LoadCheckpoint(ctx context.Context, name string) (events.LogPosition, error) {
+	var cp checkpoint
+	err := s.collection.FindOne(ctx, bson.M{"name": name}).Decode(&cp)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return events.LogPosition{}, fmt.Errorf("%w {%s}", projectors.ErrCheckpointNotFound, name)
+	}
+	if err != nil {
+		return events.LogPosition{}, err
+	}
+	return events.LogPosition{Commit: uint64(cp.Position), Prepare: uint64(cp.PreparePosition)}, nil
+}
+
+func (s *checkpointStore) SaveCheckpoint(ctx context.Context, name string, position events.LogPosition) error {
+	cp := checkpoint{
+		Name:            name,
+		Position:        int64(position.Commit),
+		PreparePosition: int64(position.Prepare),
+		UpdatedAt:       time.Now(),
+	}
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"name": name}, cp, options.Replace().SetUpsert(true))
+	return err
+}
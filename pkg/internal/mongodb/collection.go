@@ -0,0 +1,93 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// notDeleted matches the documents of projections that haven't been soft-deleted. DeletedAt is omitted from the
+// document until the projection is deleted.
+var notDeleted = bson.M{"$exists": false}
+
+// projectionCollection is a collection of projections of type T, keyed by their 'id' field.
+type projectionCollection[T any] struct {
+	collection *mongo.Collection
+}
+
+func newProjectionCollection[T any](ctx context.Context, database *mongo.Database, name string) (*projectionCollection[T], error) {
+	collection := database.Collection(name)
+	if err := ensureIndices(ctx, collection); err != nil {
+		return nil, err
+	}
+	return &projectionCollection[T]{collection: collection}, nil
+}
+
+func (c *projectionCollection[T]) upsert(ctx context.Context, id string, projection *T) error {
+	_, err := c.collection.ReplaceOne(ctx, bson.M{"id": id}, projection, options.Replace().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w {%s}: %v", projections.ErrDuplicateProjection, id, err)
+	}
+	return err
+}
+
+func (c *projectionCollection[T]) delete(ctx context.Context, id string) error {
+	filter := bson.M{"id": id, "deletedAt": notDeleted}
+	update := bson.M{"$set": bson.M{"deletedAt": time.Now()}}
+
+	result, err := c.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w {%s}", projections.ErrProjectionNotFound, id)
+	}
+	return nil
+}
+
+// findOne returns the projection that matches the filter. soft-deleted projections never match.
+func (c *projectionCollection[T]) findOne(ctx context.Context, filter bson.M) (*T, error) {
+	filter["deletedAt"] = notDeleted
+
+	var projection T
+	err := c.collection.FindOne(ctx, filter).Decode(&projection)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w %v", projections.ErrProjectionNotFound, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &projection, nil
+}
+
+// find returns every projection that matches the filter. soft-deleted projections never match.
+func (c *projectionCollection[T]) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]*T, error) {
+	filter["deletedAt"] = notDeleted
+
+	cursor, err := c.collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*T, 0)
+	if err = cursor.All(ctx, &found); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+func (c *projectionCollection[T]) deleteAll(ctx context.Context) error {
+	_, err := c.collection.DeleteMany(ctx, bson.M{})
+	return err
+}
+
+// byId orders projections by id, so lists are returned in the same order as the in-memory repositories.
+func byId() *options.FindOptions {
+	return options.Find().SetSort(bson.D{{Key: "id", Value: 1}})
+}
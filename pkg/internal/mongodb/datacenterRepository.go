@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// datacenterRepository is a MongoDB implementation of projections.DatacenterRepository.
+type datacenterRepository struct {
+	collection *projectionCollection[projections.DatacenterProjection]
+}
+
+// NewDatacenterRepository creates the repository and the indexes of its collection.
+func NewDatacenterRepository(ctx context.Context, database *mongo.Database) (*datacenterRepository, error) {
+	collection, err := newProjectionCollection[projections.DatacenterProjection](ctx, database, datacenterCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &datacenterRepository{collection: collection}, nil
+}
+
+func (r *datacenterRepository) Upsert(ctx context.Context, projection *projections.DatacenterProjection) error {
+	return r.collection.upsert(ctx, projection.ID, projection)
+}
+
+func (r *datacenterRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(ctx, id)
+}
+
+func (r *datacenterRepository) GetById(ctx context.Context, id string) (*projections.DatacenterProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *datacenterRepository) GetBySite(ctx context.Context, site string) (*projections.DatacenterProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"site": site})
+}
+
+func (r *datacenterRepository) List(ctx context.Context) ([]*projections.DatacenterProjection, error) {
+	return r.collection.find(ctx, bson.M{}, byId())
+}
+
+func (r *datacenterRepository) DeleteAll(ctx context.Context) error {
+	return r.collection.deleteAll(ctx)
+}
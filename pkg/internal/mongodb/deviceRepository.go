@@ -0,0 +1,58 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// deviceRepository is a MongoDB implementation of projections.DeviceRepository.
+type deviceRepository struct {
+	collection *projectionCollection[projections.DeviceProjection]
+}
+
+// NewDeviceRepository creates the repository and the indexes of its collection.
+func NewDeviceRepository(ctx context.Context, database *mongo.Database) (*deviceRepository, error) {
+	collection, err := newProjectionCollection[projections.DeviceProjection](ctx, database, deviceCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceRepository{collection: collection}, nil
+}
+
+func (r *deviceRepository) Upsert(ctx context.Context, projection *projections.DeviceProjection) error {
+	return r.collection.upsert(ctx, projection.ID, projection)
+}
+
+func (r *deviceRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(ctx, id)
+}
+
+func (r *deviceRepository) GetById(ctx context.Context, id string) (*projections.DeviceProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *deviceRepository) GetByHostname(ctx context.Context, hostname string) (*projections.DeviceProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"hostname": hostname})
+}
+
+func (r *deviceRepository) ListByRack(ctx context.Context, rackId string) ([]*projections.DeviceProjection, error) {
+	byElevation := options.Find().SetSort(bson.D{{Key: "elevation", Value: 1}, {Key: "id", Value: 1}})
+	return r.collection.find(ctx, bson.M{"rackId": rackId}, byElevation)
+}
+
+func (r *deviceRepository) ListByPod(ctx context.Context, podId string) ([]*projections.DeviceProjection, error) {
+	return r.collection.find(ctx, bson.M{"podId": podId}, byId())
+}
+
+func (r *deviceRepository) ListByCategory(ctx context.Context, category string) ([]*projections.DeviceProjection, error) {
+	// matches the documents whose categories array contains the category.
+	return r.collection.find(ctx, bson.M{"categories": category}, byId())
+}
+
+func (r *deviceRepository) DeleteAll(ctx context.Context) error {
+	return r.collection.deleteAll(ctx)
+}
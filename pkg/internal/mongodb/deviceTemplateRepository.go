@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// deviceTemplateRepository is a MongoDB implementation of projections.DeviceTemplateRepository.
+type deviceTemplateRepository struct {
+	collection *projectionCollection[projections.DeviceTemplateProjection]
+}
+
+// NewDeviceTemplateRepository creates the repository and the indexes of its collection.
+func NewDeviceTemplateRepository(ctx context.Context, database *mongo.Database) (*deviceTemplateRepository, error) {
+	collection, err := newProjectionCollection[projections.DeviceTemplateProjection](ctx, database, deviceTemplateCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceTemplateRepository{collection: collection}, nil
+}
+
+func (r *deviceTemplateRepository) Upsert(ctx context.Context, projection *projections.DeviceTemplateProjection) error {
+	return r.collection.upsert(ctx, projection.ID, projection)
+}
+
+func (r *deviceTemplateRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(ctx, id)
+}
+
+func (r *deviceTemplateRepository) GetById(ctx context.Context, id string) (*projections.DeviceTemplateProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *deviceTemplateRepository) ListByCategory(ctx context.Context, category string) ([]*projections.DeviceTemplateProjection, error) {
+	// matches the documents whose categories array contains the category.
+	return r.collection.find(ctx, bson.M{"categories": category}, byId())
+}
+
+func (r *deviceTemplateRepository) List(ctx context.Context) ([]*projections.DeviceTemplateProjection, error) {
+	return r.collection.find(ctx, bson.M{}, byId())
+}
+
+func (r *deviceTemplateRepository) DeleteAll(ctx context.Context) error {
+	return r.collection.deleteAll(ctx)
+}
@@ -2,6 +2,8 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,6 +16,20 @@ const (
 	maxConnIdleTime = 3 * time.Minute
 	minPoolSize     = 20
 	maxPoolSize     = 300
+	indexTimeout    = 5 * time.Second
+
+	// the code of the error returned when dropping an index that doesn't exist.
+	indexNotFoundCode = 27
+)
+
+const (
+	datacenterCollection     = "datacenter"
+	rackCollection           = "rack"
+	podCollection            = "pod"
+	deviceCollection         = "device"
+	deviceTemplateCollection = "deviceTemplate"
+	checkpointCollection     = "checkpoint"
+	sagaCollection           = "saga"
 )
 
 type Config struct {
@@ -48,66 +64,106 @@ func NewMongoDBConn(ctx context.Context, cfg *Config) (*mongo.Client, error) {
 	return client, nil
 }
 
-func ensureMongoIndices(database *mongo.Database) {
-	ensureIndex(database, "datacenter", "site", true, nil)
-}
-
-func ensureIndex(database *mongo.Database, collectionName string, field string, unique bool, partialFilterExpression any) bool {
-	createIndexOpts := &options.IndexOptions{Unique: &unique}
-	if partialFilterExpression != nil {
-		createIndexOpts.SetPartialFilterExpression(partialFilterExpression)
+// EnsureIndices creates the indexes of every collection of the read model. the repositories create the indexes of
+// their own collection when they are created, EnsureIndices can be used to create them all ahead of time.
+func EnsureIndices(ctx context.Context, database *mongo.Database) error {
+	for collectionName := range indices() {
+		if err := ensureIndices(ctx, database.Collection(collectionName)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	mod := mongo.IndexModel{
-		Keys:    bson.D{{Key: field, Value: 1}},
-		Options: createIndexOpts,
+func ensureIndices(ctx context.Context, collection *mongo.Collection) error {
+	models, ok := indices()[collection.Name()]
+	if !ok {
+		return nil
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, indexTimeout)
 	defer cancel()
 
-	collection := database.Collection(collectionName)
-	_, err := collection.Indexes().CreateOne(ctx, mod)
-	if err != nil {
-		return false
+	for _, name := range replacedIndices()[collection.Name()] {
+		var cmdErr mongo.CommandError
+		if _, err := collection.Indexes().DropOne(ctx, name); err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == indexNotFoundCode) {
+			return fmt.Errorf("DropOne {%s.%s}: %w", collection.Name(), name, err)
+		}
 	}
 
-	return true
+	if _, err := collection.Indexes().CreateMany(ctx, models); err != nil {
+		return fmt.Errorf("CreateMany {%s}: %w", collection.Name(), err)
+	}
+	return nil
 }
 
-func ensureCompoundIndex(database *mongo.Database, collectionName string) bool {
-	collection := database.Collection(collectionName)
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	compoundIndices := compoundIndices()
-
-	compoundIndex, ok := compoundIndices[collectionName]
-	if !ok {
-		return false
+func index(unique bool, fields ...string) mongo.IndexModel {
+	keys := make(bson.D, 0, len(fields))
+	for _, field := range fields {
+		keys = append(keys, bson.E{Key: field, Value: 1})
 	}
 
-	_, err := collection.Indexes().CreateMany(ctx, compoundIndex)
-	if err != nil {
-		return false
+	return mongo.IndexModel{
+		Keys:    keys,
+		Options: options.Index().SetUnique(unique),
 	}
+}
 
-	return true
+// activeIndex returns a unique index over the projections that aren't soft-deleted, so a deleted projection doesn't
+// prevent another one from taking its site or name.
+func activeIndex(fields ...string) mongo.IndexModel {
+	model := index(true, fields...)
+	name := ""
+	for _, field := range fields {
+		name += field + "_1_"
+	}
+	model.Options.
+		SetName(name + "active").
+		SetPartialFilterExpression(bson.M{"deletedAt": bson.M{"$exists": false}})
+	return model
 }
 
-func compoundIndices() map[string][]mongo.IndexModel {
-	compoundIndices := map[string][]mongo.IndexModel{
-		"pod": {
-			{
-				Keys: bson.D{
-					{Key: "datacenterId", Value: 1},
-					{Key: "name", Value: 1},
-				},
-				Options: options.Index().SetUnique(true),
-			},
+func indices() map[string][]mongo.IndexModel {
+	return map[string][]mongo.IndexModel{
+		datacenterCollection: {
+			index(true, "id"),
+			activeIndex("site"),
+		},
+		rackCollection: {
+			index(true, "id"),
+			activeIndex("datacenterId", "name"),
+		},
+		podCollection: {
+			index(true, "id"),
+			activeIndex("datacenterId", "name"),
+		},
+		deviceCollection: {
+			index(true, "id"),
+			index(false, "hostname"),
+			index(false, "rackId", "elevation"),
+			index(false, "podId"),
+			index(false, "categories"),
+		},
+		deviceTemplateCollection: {
+			index(true, "id"),
+			index(false, "categories"),
+		},
+		checkpointCollection: {
+			index(true, "name"),
+		},
+		sagaCollection: {
+			index(true, "id"),
+			index(false, "saga", "status"),
 		},
 	}
+}
 
-	return compoundIndices
+// replacedIndices returns the names of the indexes of every collection that were replaced by one in indices, they are
+// dropped before the indexes are created.
+func replacedIndices() map[string][]string {
+	return map[string][]string{
+		datacenterCollection: {"site_1"},
+		rackCollection:       {"datacenterId_1_name_1"},
+		podCollection:        {"datacenterId_1_name_1"},
+	}
 }
@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// podRepository is a MongoDB implementation of projections.PodRepository.
+type podRepository struct {
+	collection *projectionCollection[projections.PodProjection]
+}
+
+// NewPodRepository creates the repository and the indexes of its collection.
+func NewPodRepository(ctx context.Context, database *mongo.Database) (*podRepository, error) {
+	collection, err := newProjectionCollection[projections.PodProjection](ctx, database, podCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &podRepository{collection: collection}, nil
+}
+
+func (r *podRepository) Upsert(ctx context.Context, projection *projections.PodProjection) error {
+	return r.collection.upsert(ctx, projection.ID, projection)
+}
+
+func (r *podRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(ctx, id)
+}
+
+func (r *podRepository) GetById(ctx context.Context, id string) (*projections.PodProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *podRepository) GetByName(ctx context.Context, datacenterId, name string) (*projections.PodProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"datacenterId": datacenterId, "name": name})
+}
+
+func (r *podRepository) ListByDatacenter(ctx context.Context, datacenterId string) ([]*projections.PodProjection, error) {
+	return r.collection.find(ctx, bson.M{"datacenterId": datacenterId}, byId())
+}
+
+func (r *podRepository) DeleteAll(ctx context.Context) error {
+	return r.collection.deleteAll(ctx)
+}
@@ -0,0 +1,47 @@
+package mongodb
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// rackRepository is a MongoDB implementation of projections.RackRepository.
+type rackRepository struct {
+	collection *projectionCollection[projections.RackProjection]
+}
+
+// NewRackRepository creates the repository and the indexes of its collection.
+func NewRackRepository(ctx context.Context, database *mongo.Database) (*rackRepository, error) {
+	collection, err := newProjectionCollection[projections.RackProjection](ctx, database, rackCollection)
+	if err != nil {
+		return nil, err
+	}
+	return &rackRepository{collection: collection}, nil
+}
+
+func (r *rackRepository) Upsert(ctx context.Context, projection *projections.RackProjection) error {
+	return r.collection.upsert(ctx, projection.ID, projection)
+}
+
+func (r *rackRepository) Delete(ctx context.Context, id string) error {
+	return r.collection.delete(ctx, id)
+}
+
+func (r *rackRepository) GetById(ctx context.Context, id string) (*projections.RackProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"id": id})
+}
+
+func (r *rackRepository) GetByName(ctx context.Context, datacenterId, name string) (*projections.RackProjection, error) {
+	return r.collection.findOne(ctx, bson.M{"datacenterId": datacenterId, "name": name})
+}
+
+func (r *rackRepository) ListByDatacenter(ctx context.Context, datacenterId string) ([]*projections.RackProjection, error) {
+	return r.collection.find(ctx, bson.M{"datacenterId": datacenterId}, byId())
+}
+
+func (r *rackRepository) DeleteAll(ctx context.Context) error {
+	return r.collection.deleteAll(ctx)
+}
@@ -0,0 +1,48 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/sagas"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// sagaStateStore is a MongoDB implementation of sagas.StateStore.
+type sagaStateStore struct {
+	collection *mongo.Collection
+}
+
+// NewSagaStateStore creates the store and the indexes of its collection.
+func NewSagaStateStore(ctx context.Context, database *mongo.Database) (*sagaStateStore, error) {
+	collection := database.Collection(sagaCollection)
+	if err := ensureIndices(ctx, collection); err != nil {
+		return nil, err
+	}
+	return &sagaStateStore{collection: collection}, nil
+}
+
+func (s *sagaStateStore) LoadState(ctx context.Context, id string) (*sagas.State, error) {
+	var state sagas.State
+	err := s.collection.FindOne(ctx, bson.M{"id": id}).Decode(&state)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w {%s}", sagas.ErrStateNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (s *sagaStateStore) SaveState(ctx context.Context, state *sagas.State) error {
+	_, err := s.collection.ReplaceOne(ctx, bson.M{"id": state.ID}, state, options.Replace().SetUpsert(true))
+	return err
+}
+
+func (s *sagaStateStore) DeleteAll(ctx context.Context) error {
+	_, err := s.collection.DeleteMany(ctx, bson.M{})
+	return err
+}
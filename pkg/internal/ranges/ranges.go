@@ -31,6 +31,7 @@ var ErrMalformedRange = errors.New("malformed range")
 // convention:
 // `1-5` consecutive range, min: 1, max: 5 (both upper and lower boundaries are inclusive)
 // '1,2,4,5' non-consecutive range
+// '42' a single number
 // '*' signifies any number is valid
 // '10-*' denotes a continuous range with no upper boundary (inclusive)
 // '*-20' denotes a continuous range with no lower boundary (inclusive).
@@ -93,6 +94,12 @@ func ParseRange(s string) (Range, error) {
 		r = NewSpecificRange(values)
 	case s == rangeWildcard:
 		r = NewUnboundedRange()
+	default:
+		num, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w {%s}: error: {%v}", ErrMalformedRange, original, err)
+		}
+		r = NewSpecificRange([]int{num})
 	}
 	if mod != UnknownModifier {
 		r = NewModifiedRange(mod, r)
@@ -9,17 +9,14 @@ type magnitude struct {
 }
 
 func (m magnitude) makeUnit(base Unit) Unit {
-	var pow int
-	if !base.IsBaseUnit() {
-		base = *base.baseUnit
-		pow = base.baseRatio
-	}
-
 	name := m.Prefix + base.Name
 	symbol := m.Symbol + base.Symbol
 
-	pow = m.Power - pow
-	ratio := 1 * int(math.Pow(10.0, float64(pow)))
+	ratio := math.Pow(10.0, float64(m.Power))
+	if !base.IsBaseUnit() {
+		ratio *= base.baseRatio
+		base = *base.baseUnit
+	}
 
 	u := Unit{
 		Name:      name,
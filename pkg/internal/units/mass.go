@@ -0,0 +1,25 @@
+package units
+
+import "fmt"
+
+var (
+	Gram     = NewBaseUnit("gram", "g")
+	KiloGram = Kilo(Gram)
+	// a pound is 453.59237 grams.
+	Pound = newUnit("pound", "lb", Gram, 453.59237)
+)
+
+// ParseMass is like ParseValue but returns an error if the value isn't a mass, e.g. '18kg' or '40lb'.
+func ParseMass(s string) (Value, error) {
+	v, err := ParseValue(s)
+	if err != nil {
+		return v, err
+	}
+	if !v.IsZero() && !v.unit.Measures(Gram) {
+		return Value{}, fmt.Errorf("'%s' is not a mass", s)
+	}
+	if v.mag < 0 {
+		return Value{}, fmt.Errorf("'%s' is negative", s)
+	}
+	return v, nil
+}
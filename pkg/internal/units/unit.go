@@ -1,6 +1,9 @@
 package units
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 var unitRegistry = make(map[string]Unit)
 
@@ -13,8 +16,8 @@ type Unit struct {
 
 	// the base unit (e.g. the base unit for Gigabits would be bits)
 	baseUnit *Unit
-	// the ratio of base unit to the unit as a whole number (e.g. the ratio of bits to Megabits is 1000 b/ 1 Mb = 1000)
-	baseRatio int
+	// the ratio of base unit to the unit (e.g. the ratio of bits to Megabits is 1000 b/ 1 Mb = 1000)
+	baseRatio float64
 }
 
 func NewBaseUnit(name, symbol string) Unit {
@@ -32,10 +35,35 @@ func NewBaseUnit(name, symbol string) Unit {
 	return u
 }
 
+// newUnit registers a unit that isn't a decimal multiple of its base unit, e.g. pounds of grams.
+func newUnit(name, symbol string, base Unit, ratio float64) Unit {
+	u := Unit{
+		Name:      name,
+		Symbol:    symbol,
+		baseUnit:  &base,
+		baseRatio: ratio,
+	}
+	unitRegistry[name] = u
+	return u
+}
+
 func (u Unit) IsBaseUnit() bool {
 	return u.baseUnit == nil
 }
 
+// Base returns the base unit of the unit, a base unit is its own base unit.
+func (u Unit) Base() Unit {
+	if u.IsBaseUnit() {
+		return u
+	}
+	return *u.baseUnit
+}
+
+// Measures returns true if the unit measures the same quantity as the passed unit, e.g. kilograms and pounds.
+func (u Unit) Measures(o Unit) bool {
+	return u.Name != "" && u.Base().Name == o.Base().Name
+}
+
 func FindUnit(u string) (Unit, error) {
 	unit, ok := unitRegistry[u]
 	if ok {
@@ -48,5 +76,16 @@ func FindUnit(u string) (Unit, error) {
 		}
 	}
 
+	// symbols are case-sensitive, but a symbol that only differs from one unit's by case is unambiguous. (e.g. 'kg')
+	var matches []Unit
+	for _, unit = range unitRegistry {
+		if strings.EqualFold(unit.Symbol, u) {
+			matches = append(matches, unit)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
 	return Unit{}, fmt.Errorf("no unit found with name or symbol '%s'", u)
 }
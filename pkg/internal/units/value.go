@@ -2,6 +2,9 @@ package units
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -18,13 +21,20 @@ func NewValue(mag int, u Unit) Value {
 	}
 }
 
+// decimalValue matches a value with a fractional magnitude, e.g. '18.5kg'.
+var decimalValue = regexp.MustCompile(`^\s*([0-9]*\.[0-9]+)\s*(\S+)\s*$`)
+
 // ParseValue attempts to parse the passed string into a Value, returning the parsed Value and an error
-// if the string could not be parsed.
+// if the string could not be parsed. a value with a fractional magnitude is converted to the nearest whole number of
+// its base unit, e.g. '1.5kg' is parsed as 1500g.
 func ParseValue(s string) (Value, error) {
 	var v Value
 	if s == "" {
 		return v, nil
 	}
+	if match := decimalValue.FindStringSubmatch(s); match != nil {
+		return parseDecimalValue(match[1], match[2])
+	}
 	n, u := ExtractInt(s)
 	u = strings.ReplaceAll(u, " ", "")
 
@@ -38,20 +48,75 @@ func ParseValue(s string) (Value, error) {
 	return v, nil
 }
 
+func parseDecimalValue(magnitude, symbol string) (Value, error) {
+	f, err := strconv.ParseFloat(magnitude, 64)
+	if err != nil {
+		return Value{}, err
+	}
+	unit, err := FindUnit(symbol)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{mag: int(math.Round(f * unit.baseRatio)), unit: unit.Base()}, nil
+}
+
+// IsZero returns true for the zero Value, which has no unit.
+func (v Value) IsZero() bool {
+	return v.unit.Name == ""
+}
+
+func (v Value) Unit() Unit {
+	return v.unit
+}
+
+// In returns the magnitude of the value in the passed unit. the zero Value is 0 in every unit.
+func (v Value) In(u Unit) (float64, error) {
+	if v.IsZero() {
+		return 0, nil
+	}
+	if !v.unit.Measures(u) {
+		return 0, fmt.Errorf("unsupported conversion: %s to %s", v.unit.Name, u.Name)
+	}
+	return float64(v.mag) * v.unit.baseRatio / u.baseRatio, nil
+}
+
+// String returns the value in the form accepted by ParseValue, the zero Value is empty.
 func (v Value) String() string {
+	if v.IsZero() {
+		return ""
+	}
 	return fmt.Sprintf("%v%s", v.mag, v.unit.Symbol)
 }
 
+// MustConvert converts the value to the nearest whole number of the passed unit.
 func (v Value) MustConvert(u Unit) (Value, error) {
-	if u.baseUnit.Name != v.unit.baseUnit.Name {
-		return v, fmt.Errorf("unsupported conversion: %s to %s", u.Name, v.unit.Name)
+	f, err := v.In(u)
+	if err != nil {
+		return v, err
 	}
 
-	m := v.mag * (u.baseRatio / v.unit.baseRatio)
 	val := Value{
-		mag:  m,
+		mag:  int(math.Round(f)),
 		unit: u,
 	}
 
 	return val, nil
 }
+
+// MarshalText encodes the value in the form accepted by ParseValue (e.g. '10Gb'), so that values survive being
+// saved in events.
+func (v Value) MarshalText() ([]byte, error) {
+	if v.unit.Name == "" {
+		return []byte{}, nil
+	}
+	return []byte(v.String()), nil
+}
+
+func (v *Value) UnmarshalText(text []byte) error {
+	value, err := ParseValue(string(text))
+	if err != nil {
+		return err
+	}
+	*v = value
+	return nil
+}
@@ -0,0 +1,46 @@
+package projectors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// CheckpointStore persists the position of the last event a runner has projected.
+type CheckpointStore interface {
+	// LoadCheckpoint returns the saved position of the named runner. it returns ErrCheckpointNotFound if the runner
+	// has never saved a checkpoint.
+	LoadCheckpoint(ctx context.Context, name string) (events.LogPosition, error)
+	SaveCheckpoint(ctx context.Context, name string, position events.LogPosition) error
+}
+
+// memoryCheckpointStore is an in-process implementation of CheckpointStore.
+type memoryCheckpointStore struct {
+	mu          sync.RWMutex
+	checkpoints map[string]events.LogPosition
+}
+
+func NewMemoryCheckpointStore() *memoryCheckpointStore {
+	return &memoryCheckpointStore{checkpoints: make(map[string]events.LogPosition)}
+}
+
+func (s *memoryCheckpointStore) LoadCheckpoint(ctx context.Context, name string) (events.LogPosition, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	position, ok := s.checkpoints[name]
+	if !ok {
+		return events.LogPosition{}, fmt.Errorf("%w {%s}", ErrCheckpointNotFound, name)
+	}
+	return position, nil
+}
+
+func (s *memoryCheckpointStore) SaveCheckpoint(ctx context.Context, name string, position events.LogPosition) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[name] = position
+	return nil
+}
@@ -0,0 +1,52 @@
+package projectors
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// datacenterProjector projects datacenter events into projections.DatacenterProjection.
+type datacenterProjector struct {
+	repo projections.DatacenterRepository
+}
+
+func NewDatacenterProjector(repo projections.DatacenterRepository) *datacenterProjector {
+	return &datacenterProjector{repo: repo}
+}
+
+func (p *datacenterProjector) Name() string {
+	return "datacenter"
+}
+
+func (p *datacenterProjector) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.DatacenterCreated:
+		return p.onCreate(ctx, event)
+	default:
+		return nil
+	}
+}
+
+func (p *datacenterProjector) Reset(ctx context.Context) error {
+	return p.repo.DeleteAll(ctx)
+}
+
+func (p *datacenterProjector) onCreate(ctx context.Context, event events.Event) error {
+	var data eventsv1.DatacenterCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.repo.Upsert(ctx, &projections.DatacenterProjection{
+		BaseProjection: projections.BaseProjection{CreatedAt: event.GetTimestamp()},
+		ID:             datacenterAggregate.GetDatacenterAggregateId(event.GetAggregateId()),
+		Site:           data.Site,
+		Building:       data.Building,
+		Room:           data.Room,
+		Providers:      data.Providers,
+	})
+}
@@ -0,0 +1,64 @@
+package projectors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// DeadLetter is an event a runner skipped because it failed to project permanently.
+type DeadLetter struct {
+	// the name of the runner that skipped the event.
+	Runner string
+	// the name of the projector that failed to project the event, empty if it was skipped by every projector.
+	Projector string
+	Event     events.Event
+	// the error the event failed to project with.
+	Error     string
+	Timestamp time.Time
+}
+
+func NewDeadLetter(runner, projector string, event events.Event, cause error) DeadLetter {
+	return DeadLetter{
+		Runner:    runner,
+		Projector: projector,
+		Event:     event,
+		Error:     cause.Error(),
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// DeadLetterStore keeps the events runners skipped so they can be inspected, and replayed once they can be projected.
+type DeadLetterStore interface {
+	AddDeadLetter(ctx context.Context, letter DeadLetter) error
+}
+
+// memoryDeadLetterStore is an in-process implementation of DeadLetterStore.
+type memoryDeadLetterStore struct {
+	mu      sync.RWMutex
+	letters []DeadLetter
+}
+
+func NewMemoryDeadLetterStore() *memoryDeadLetterStore {
+	return &memoryDeadLetterStore{letters: make([]DeadLetter, 0)}
+}
+
+func (s *memoryDeadLetterStore) AddDeadLetter(ctx context.Context, letter DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+// DeadLetters returns the dead letters in the order they were added.
+func (s *memoryDeadLetterStore) DeadLetters() []DeadLetter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	letters := make([]DeadLetter, len(s.letters))
+	copy(letters, s.letters)
+	return letters
+}
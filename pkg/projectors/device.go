@@ -0,0 +1,179 @@
+package projectors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// deviceProjector projects device events, and the rack events that place devices, into projections.DeviceProjection.
+type deviceProjector struct {
+	repo  projections.DeviceRepository
+	racks projections.RackRepository
+}
+
+// NewDeviceProjector creates a device projector. the rack repository is used to resolve the datacenter of a device
+// from its rack, so the rack projector must run before the device projector.
+func NewDeviceProjector(repo projections.DeviceRepository, racks projections.RackRepository) *deviceProjector {
+	return &deviceProjector{repo: repo, racks: racks}
+}
+
+func (p *deviceProjector) Name() string {
+	return "device"
+}
+
+func (p *deviceProjector) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.DeviceCreated:
+		return p.onCreate(ctx, event)
+	case eventsv1.DeviceRacked:
+		return p.onRack(ctx, event)
+	case eventsv1.DeviceUnracked:
+		return p.onUnrack(ctx, event)
+	case eventsv1.DeviceMoved:
+		return p.onMove(ctx, event)
+	case eventsv1.DeviceDecommissioned:
+		return p.onDecommission(ctx, event)
+	default:
+		return nil
+	}
+}
+
+func (p *deviceProjector) Reset(ctx context.Context) error {
+	return p.repo.DeleteAll(ctx)
+}
+
+func (p *deviceProjector) onCreate(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	datacenterId, err := p.datacenterOf(ctx, data.RackId)
+	if err != nil {
+		return err
+	}
+
+	return p.repo.Upsert(ctx, &projections.DeviceProjection{
+		BaseProjection: projections.BaseProjection{CreatedAt: event.GetTimestamp()},
+		ID:             deviceAggregate.GetDeviceAggregateId(event.GetAggregateId()),
+		Hostname:       data.Hostname,
+		Elevation:      data.Elevation,
+		Designation:    string(data.Designation),
+		Cluster:        data.Cluster,
+		Instance:       data.Instance,
+		Categories:     data.Categories,
+		ModelId:        data.ModelId,
+		PodId:          data.PodId,
+		RackId:         data.RackId,
+		DatacenterId:   datacenterId,
+	})
+}
+
+func (p *deviceProjector) onRack(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceRackedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	device, err := p.repo.GetById(ctx, data.DeviceId)
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		// the device is projected with its rack and elevation once it is created.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	device.RackId = rackAggregate.GetRackAggregateId(event.GetAggregateId())
+	device.Elevation = data.Elevation
+	device.DatacenterId, err = p.datacenterOf(ctx, device.RackId)
+	if err != nil {
+		return err
+	}
+	device.UpdatedAt = event.GetTimestamp()
+
+	return p.repo.Upsert(ctx, device)
+}
+
+// onUnrack clears the rack and elevation of the device, it stays in its datacenter.
+func (p *deviceProjector) onUnrack(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceUnrackedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	device, err := p.repo.GetById(ctx, data.DeviceId)
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if device.RackId != rackAggregate.GetRackAggregateId(event.GetAggregateId()) {
+		// the device has been racked elsewhere since.
+		return nil
+	}
+	device.RackId = ""
+	device.Elevation = 0
+	device.UpdatedAt = event.GetTimestamp()
+
+	return p.repo.Upsert(ctx, device)
+}
+
+func (p *deviceProjector) onMove(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceMovedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	device, err := p.repo.GetById(ctx, deviceAggregate.GetDeviceAggregateId(event.GetAggregateId()))
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	device.Hostname = data.Hostname
+	device.RackId = data.RackId
+	device.Elevation = data.Elevation
+	device.DatacenterId, err = p.datacenterOf(ctx, device.RackId)
+	if err != nil {
+		return err
+	}
+	device.UpdatedAt = event.GetTimestamp()
+
+	return p.repo.Upsert(ctx, device)
+}
+
+func (p *deviceProjector) onDecommission(ctx context.Context, event events.Event) error {
+	err := p.repo.Delete(ctx, deviceAggregate.GetDeviceAggregateId(event.GetAggregateId()))
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		// already deleted by an earlier delivery of the event.
+		return nil
+	}
+	return err
+}
+
+// datacenterOf returns the id of the datacenter of the rack, or an empty string if the rack hasn't been projected.
+func (p *deviceProjector) datacenterOf(ctx context.Context, rackId string) (string, error) {
+	if rackId == "" {
+		return "", nil
+	}
+
+	rack, err := p.racks.GetById(ctx, rackId)
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return rack.DatacenterId, nil
+}
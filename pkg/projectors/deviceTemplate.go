@@ -0,0 +1,63 @@
+package projectors
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/deviceTemplateAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// deviceTemplateProjector projects device template events into projections.DeviceTemplateProjection.
+type deviceTemplateProjector struct {
+	repo projections.DeviceTemplateRepository
+}
+
+func NewDeviceTemplateProjector(repo projections.DeviceTemplateRepository) *deviceTemplateProjector {
+	return &deviceTemplateProjector{repo: repo}
+}
+
+func (p *deviceTemplateProjector) Name() string {
+	return "deviceTemplate"
+}
+
+func (p *deviceTemplateProjector) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.DeviceTemplateCreated:
+		return p.onCreate(ctx, event)
+	default:
+		return nil
+	}
+}
+
+func (p *deviceTemplateProjector) Reset(ctx context.Context) error {
+	return p.repo.DeleteAll(ctx)
+}
+
+func (p *deviceTemplateProjector) onCreate(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceTemplateCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.repo.Upsert(ctx, &projections.DeviceTemplateProjection{
+		BaseProjection:   projections.BaseProjection{CreatedAt: event.GetTimestamp()},
+		ID:               deviceTemplateAggregate.GetDeviceTemplateAggregateId(event.GetAggregateId()),
+		Variant:          data.Variant,
+		Function:         string(data.Function),
+		Categories:       data.Categories,
+		HostnameTemplate: data.HostnameTemplate,
+		Alias:            data.Alias,
+		ModelId:          data.ModelId,
+		FormFactor:       data.FormFactor,
+		Face:             string(data.Face),
+		Depth:            string(data.Depth),
+		Width:            string(data.Width),
+		Weight:           data.Weight,
+		TypicalPower:     data.TypicalPower,
+		MaxPower:         data.MaxPower,
+		PSUs:             data.PSUs,
+		Placement:        string(data.Placement),
+	})
+}
@@ -0,0 +1,115 @@
+package projectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	commandsv1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/hardware"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// deletingDeviceRepository records the projections of the devices as they are deleted.
+type deletingDeviceRepository struct {
+	projections.DeviceRepository
+	deleted map[string]projections.DeviceProjection
+}
+
+func (r *deletingDeviceRepository) Delete(ctx context.Context, id string) error {
+	projection, err := r.DeviceRepository.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = r.DeviceRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.deleted[id] = *projection
+	return nil
+}
+
+func TestDeviceProjectorDecommission(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+
+	for _, handle := range []func() error{
+		func() error {
+			return commandsv1.NewInitDatacenterHandler(store, log).Handle(ctx, commandsv1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil))
+		},
+		func() error {
+			return commandsv1.NewCreateRackCmdHandler(store, log).Handle(ctx, commandsv1.NewCreateRackCommand("r1", "a01", 42, "dc1"))
+		},
+		func() error {
+			return commandsv1.NewDatacenterAddRackCmdHandler(store, log).Handle(ctx, commandsv1.NewDatacenterAddRackCommand("dc1", "r1"))
+		},
+		func() error {
+			cmd := commandsv1.NewCreateDeviceTemplateCommand("t1", "srv", 1, hardware.Mounting{}, "", hardware.PowerDraw{}, "", []string{"compute"}, "{{.Site}}-srv{{.Number}}", "", "", "")
+			return commandsv1.NewCreateDeviceTemplateCmdHandler(store, log).Handle(ctx, cmd)
+		},
+		func() error {
+			return commandsv1.NewCreateDeviceCmdHandler(store, log).Handle(ctx, commandsv1.NewCreateDeviceCommand("d1", "t1", 10, "r1", 0, "", "", ""))
+		},
+		func() error {
+			return commandsv1.NewCreateDeviceCmdHandler(store, log).Handle(ctx, commandsv1.NewCreateDeviceCommand("d2", "t1", 20, "r1", 0, "", "", ""))
+		},
+	} {
+		if err := handle(); err != nil {
+			t.Fatalf("setting up the datacenter: %v", err)
+		}
+	}
+
+	racks := projections.NewMemoryRackRepository()
+	devices := &deletingDeviceRepository{DeviceRepository: projections.NewMemoryDeviceRepository(), deleted: make(map[string]projections.DeviceProjection)}
+	runner := NewRunner(log, store, NewMemoryCheckpointStore(), []Projector{NewRackProjector(racks), NewDeviceProjector(devices, racks)})
+	if err := runner.CatchUp(ctx); err != nil {
+		t.Fatalf("CatchUp: %v", err)
+	}
+	if device, err := devices.GetById(ctx, "d1"); err != nil || device.RackId != "r1" || device.Elevation != 10 {
+		t.Fatalf("expected d1 at r1/10, got %+v, %v", device, err)
+	}
+
+	if err := commandsv1.NewDecommissionDeviceCmdHandler(store, log).Handle(ctx, commandsv1.NewDecommissionDeviceCommand("d1", "end of life")); err != nil {
+		t.Fatalf("DecommissionDevice: %v", err)
+	}
+	if err := runner.CatchUp(ctx); err != nil {
+		t.Fatalf("CatchUp: %v", err)
+	}
+
+	// the device is unracked, then deleted with its hostname and instance.
+	deleted, ok := devices.deleted["d1"]
+	if !ok {
+		t.Fatalf("expected d1 to be deleted")
+	}
+	if deleted.Hostname != "DAL1-SRV01" || deleted.Instance != 1 || deleted.RackId != "" || deleted.DatacenterId != "dc1" || deleted.CreatedAt.IsZero() {
+		t.Fatalf("expected the unracked DAL1-SRV01 of dc1 to be deleted, got %+v", deleted)
+	}
+	if _, err := devices.GetById(ctx, "d1"); !errors.Is(err, projections.ErrProjectionNotFound) {
+		t.Fatalf("expected %v, got %v", projections.ErrProjectionNotFound, err)
+	}
+	if _, err := devices.GetByHostname(ctx, "DAL1-SRV01"); !errors.Is(err, projections.ErrProjectionNotFound) {
+		t.Fatalf("expected %v, got %v", projections.ErrProjectionNotFound, err)
+	}
+	listed, err := devices.ListByCategory(ctx, "compute")
+	if err != nil {
+		t.Fatalf("ListByCategory: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != "d2" {
+		t.Fatalf("expected only d2 in compute, got %v", listed)
+	}
+
+	// the history of the device stays in the store, and a decommission delivered again is a no-op.
+	evts, err := store.LoadEvents(ctx, "device-d1")
+	if err != nil {
+		t.Fatalf("LoadEvents: %v", err)
+	}
+	if len(evts) < 2 || evts[0].GetEventType() != eventsv1.DeviceCreated || evts[len(evts)-1].GetEventType() != eventsv1.DeviceDecommissioned {
+		t.Fatalf("expected the history of d1 from its creation to its decommission, got %d events", len(evts))
+	}
+	if err = NewDeviceProjector(devices, racks).Project(ctx, evts[len(evts)-1]); err != nil {
+		t.Fatalf("projecting the decommission again: %v", err)
+	}
+}
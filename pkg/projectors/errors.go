@@ -0,0 +1,11 @@
+package projectors
+
+import "errors"
+
+var (
+	ErrCheckpointNotFound = errors.New("checkpoint not found")
+	ErrNoProjectors       = errors.New("no projectors")
+	// ErrUndecodableEvent is wrapped by projectors to report an event that can't be projected however many times it
+	// is retried, the event is skipped.
+	ErrUndecodableEvent = errors.New("undecodable event")
+)
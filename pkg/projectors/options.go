@@ -0,0 +1,102 @@
+package projectors
+
+import "time"
+
+const (
+	defaultRunnerName   = "projections"
+	defaultBatchSize    = 500
+	defaultPollInterval = time.Second
+	defaultMaxAttempts  = 5
+	defaultMinBackoff   = 100 * time.Millisecond
+	defaultMaxBackoff   = 10 * time.Second
+)
+
+// RunnerOption configures a Runner.
+type RunnerOption func(*runnerOptions)
+
+type runnerOptions struct {
+	// the name the checkpoint of the runner is saved under.
+	name string
+	// the maximum number of events read per batch.
+	batchSize int
+	// how long the runner waits for new events once it has caught up.
+	pollInterval time.Duration
+	// the number of times an event is projected before the runner gives up on it.
+	maxAttempts int
+	// the bounds of the exponential backoff between attempts.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// where the events that fail to project permanently are kept, if anywhere.
+	deadLetters DeadLetterStore
+}
+
+func newRunnerOptions(opts ...RunnerOption) runnerOptions {
+	options := runnerOptions{
+		name:         defaultRunnerName,
+		batchSize:    defaultBatchSize,
+		pollInterval: defaultPollInterval,
+		maxAttempts:  defaultMaxAttempts,
+		minBackoff:   defaultMinBackoff,
+		maxBackoff:   defaultMaxBackoff,
+	}
+	for _, op := range opts {
+		op(&options)
+	}
+	return options
+}
+
+// WithName sets the name the checkpoint of the runner is saved under. (default "projections")
+// runners that feed different projectors must use different names.
+func WithName(name string) RunnerOption {
+	return func(o *runnerOptions) {
+		if name != "" {
+			o.name = name
+		}
+	}
+}
+
+// WithBatchSize sets the maximum number of events read, and projected, between checkpoints. (default 500)
+func WithBatchSize(n int) RunnerOption {
+	return func(o *runnerOptions) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithPollInterval sets how long the runner waits before reading again once it has caught up. (default 1s)
+func WithPollInterval(d time.Duration) RunnerOption {
+	return func(o *runnerOptions) {
+		if d > 0 {
+			o.pollInterval = d
+		}
+	}
+}
+
+// WithMaxAttempts sets the number of times a failing event is projected before the runner stops. (default 5)
+func WithMaxAttempts(n int) RunnerOption {
+	return func(o *runnerOptions) {
+		if n > 0 {
+			o.maxAttempts = n
+		}
+	}
+}
+
+// WithBackoff sets the delay before the first retry and the maximum delay between retries. (default 100ms, 10s)
+// the delay doubles after every failed attempt.
+func WithBackoff(min, max time.Duration) RunnerOption {
+	return func(o *runnerOptions) {
+		if min > 0 && max >= min {
+			o.minBackoff = min
+			o.maxBackoff = max
+		}
+	}
+}
+
+// WithDeadLetterStore sets the store the events that fail to project permanently are added to before they are skipped.
+// (default: the events are only logged)
+func WithDeadLetterStore(store DeadLetterStore) RunnerOption {
+	return func(o *runnerOptions) {
+		o.deadLetters = store
+	}
+}
@@ -0,0 +1,65 @@
+package projectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// podProjector projects pod events into projections.PodProjection.
+type podProjector struct {
+	repo projections.PodRepository
+}
+
+func NewPodProjector(repo projections.PodRepository) *podProjector {
+	return &podProjector{repo: repo}
+}
+
+func (p *podProjector) Name() string {
+	return "pod"
+}
+
+func (p *podProjector) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.PodCreated:
+		return p.onCreate(ctx, event)
+	case eventsv1.PodDeleted:
+		return p.onDelete(ctx, event)
+	default:
+		return nil
+	}
+}
+
+func (p *podProjector) Reset(ctx context.Context) error {
+	return p.repo.DeleteAll(ctx)
+}
+
+func (p *podProjector) onCreate(ctx context.Context, event events.Event) error {
+	var data eventsv1.PodCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.repo.Upsert(ctx, &projections.PodProjection{
+		BaseProjection: projections.BaseProjection{CreatedAt: event.GetTimestamp()},
+		ID:             podAggregate.GetPodAggregateId(event.GetAggregateId()),
+		Name:           fmt.Sprintf("%s%d", data.Function, data.Instance),
+		Function:       string(data.Function),
+		Instance:       data.Instance,
+		DatacenterId:   data.DatacenterId,
+	})
+}
+
+func (p *podProjector) onDelete(ctx context.Context, event events.Event) error {
+	err := p.repo.Delete(ctx, podAggregate.GetPodAggregateId(event.GetAggregateId()))
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		// already deleted by an earlier delivery of the event.
+		return nil
+	}
+	return err
+}
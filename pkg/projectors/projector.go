@@ -0,0 +1,18 @@
+package projectors
+
+import (
+	"context"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// Projector applies events to a read model.
+type Projector interface {
+	// Name identifies the projector in logs.
+	Name() string
+	// Project applies the event to the read model. events the projector isn't interested in are ignored.
+	// Project must be idempotent, an event can be projected more than once after a failure.
+	Project(ctx context.Context, event events.Event) error
+	// Reset deletes everything the projector has written so the read model can be rebuilt from the first event.
+	Reset(ctx context.Context) error
+}
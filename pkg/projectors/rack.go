@@ -0,0 +1,196 @@
+package projectors
+
+import (
+	"context"
+	"errors"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+)
+
+// rackProjector projects rack events into projections.RackProjection, including the power drawn by the devices racked
+// in a rack.
+type rackProjector struct {
+	repo projections.RackRepository
+}
+
+func NewRackProjector(repo projections.RackRepository) *rackProjector {
+	return &rackProjector{repo: repo}
+}
+
+func (p *rackProjector) Name() string {
+	return "rack"
+}
+
+func (p *rackProjector) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.RackCreated:
+		return p.onCreate(ctx, event)
+	case eventsv1.RackPowerConfigured:
+		return p.onPowerConfigure(ctx, event)
+	case eventsv1.RackLoadConfigured:
+		return p.onLoadConfigure(ctx, event)
+	case eventsv1.RackRUsReserved:
+		return p.onRUsReserve(ctx, event)
+	case eventsv1.RackRUsReleased:
+		return p.onRUsRelease(ctx, event)
+	case eventsv1.DeviceRacked:
+		return p.onDeviceAdd(ctx, event)
+	case eventsv1.DeviceUnracked:
+		return p.onDeviceRemove(ctx, event)
+	case eventsv1.RackDeleted:
+		return p.onDelete(ctx, event)
+	default:
+		return nil
+	}
+}
+
+func (p *rackProjector) Reset(ctx context.Context) error {
+	return p.repo.DeleteAll(ctx)
+}
+
+func (p *rackProjector) onCreate(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.repo.Upsert(ctx, &projections.RackProjection{
+		BaseProjection: projections.BaseProjection{CreatedAt: event.GetTimestamp()},
+		ID:             rackAggregate.GetRackAggregateId(event.GetAggregateId()),
+		Name:           data.Name,
+		Size:           data.Size,
+		DatacenterId:   data.DatacenterId,
+	})
+}
+
+func (p *rackProjector) onPowerConfigure(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackPowerConfiguredEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.Feeds = data.Feeds
+		rack.DeratingThreshold = data.DeratingThreshold
+		rack.PowerPolicy = string(data.Policy)
+	})
+}
+
+func (p *rackProjector) onLoadConfigure(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackLoadConfiguredEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.StaticRating = data.StaticRating
+		rack.DynamicRating = data.DynamicRating
+		rack.HeavyDeviceWeight = data.HeavyDeviceWeight
+		rack.HeavyDeviceMaxElevation = data.HeavyDeviceMaxElevation
+	})
+}
+
+func (p *rackProjector) onRUsReserve(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackRUsReservedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.Reservations = append(withoutReservation(rack.Reservations, data.RUs), projections.RackReservation{
+			RUs:        data.RUs,
+			Reason:     data.Reason,
+			Categories: data.Categories,
+		})
+	})
+}
+
+func (p *rackProjector) onRUsRelease(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackRUsReleasedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.Reservations = withoutReservation(rack.Reservations, data.RUs)
+	})
+}
+
+func (p *rackProjector) onDeviceAdd(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceRackedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.Devices = append(withoutDevice(rack.Devices, data.DeviceId), projections.RackedDevice{
+			DeviceId:     data.DeviceId,
+			Weight:       data.Weight,
+			TypicalPower: data.TypicalPower,
+			MaxPower:     data.MaxPower,
+			PSUs:         data.PSUs,
+		})
+	})
+}
+
+func (p *rackProjector) onDeviceRemove(ctx context.Context, event events.Event) error {
+	var data eventsv1.DeviceUnrackedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+
+	return p.update(ctx, event, func(rack *projections.RackProjection) {
+		rack.Devices = withoutDevice(rack.Devices, data.DeviceId)
+	})
+}
+
+// update applies fn to the projection of the rack of the event, a rack that hasn't been projected is ignored.
+func (p *rackProjector) update(ctx context.Context, event events.Event, fn func(rack *projections.RackProjection)) error {
+	rack, err := p.repo.GetById(ctx, rackAggregate.GetRackAggregateId(event.GetAggregateId()))
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	fn(rack)
+	rack.UpdatedAt = event.GetTimestamp()
+
+	return p.repo.Upsert(ctx, rack)
+}
+
+// withoutDevice returns the devices, without the device. a redelivered event doesn't count a device twice.
+func withoutDevice(devices []projections.RackedDevice, deviceId string) []projections.RackedDevice {
+	kept := make([]projections.RackedDevice, 0, len(devices))
+	for _, device := range devices {
+		if device.DeviceId != deviceId {
+			kept = append(kept, device)
+		}
+	}
+	return kept
+}
+
+func (p *rackProjector) onDelete(ctx context.Context, event events.Event) error {
+	err := p.repo.Delete(ctx, rackAggregate.GetRackAggregateId(event.GetAggregateId()))
+	if errors.Is(err, projections.ErrProjectionNotFound) {
+		// already deleted by an earlier delivery of the event.
+		return nil
+	}
+	return err
+}
+
+// withoutReservation returns the reservations, without the reservation of the RU(s). a redelivered event doesn't
+// reserve the RU(s) twice.
+func withoutReservation(reservations []projections.RackReservation, rus string) []projections.RackReservation {
+	kept := make([]projections.RackReservation, 0, len(reservations))
+	for _, reservation := range reservations {
+		if reservation.RUs != rus {
+			kept = append(kept, reservation)
+		}
+	}
+	return kept
+}
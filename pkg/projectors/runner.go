@@ -0,0 +1,220 @@
+package projectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// Runner is a catch-up subscription to every stream of an event store. it reads events in batches from the last
+// saved checkpoint, dispatches them to its projectors in order, and saves the checkpoint after each batch. once it
+// has caught up it polls the store for new events. an event that fails to project permanently, e.g. because it can't
+// be decoded, is skipped and added to the runner's DeadLetterStore, if it has one. any other failure is retried and stops
+// the runner once the maximum number of attempts is reached.
+type Runner struct {
+	log         logger.Logger
+	reader      events.AllEventsReader
+	checkpoints CheckpointStore
+	projectors  []Projector
+	opts        runnerOptions
+}
+
+func NewRunner(log logger.Logger, reader events.AllEventsReader, checkpoints CheckpointStore, projectors []Projector, opts ...RunnerOption) *Runner {
+	return &Runner{
+		log:         log,
+		reader:      reader,
+		checkpoints: checkpoints,
+		projectors:  projectors,
+		opts:        newRunnerOptions(opts...),
+	}
+}
+
+// Run projects events until the context is cancelled or an event fails to project after the maximum number of
+// attempts. cancelling the context is not an error.
+func (r *Runner) Run(ctx context.Context) error {
+	if len(r.projectors) == 0 {
+		return ErrNoProjectors
+	}
+
+	position, err := r.loadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	r.log.Infof("starting projection runner {%s} from position %d", r.opts.name, position.Commit)
+
+	for {
+		projected, err := r.catchUp(ctx, position)
+		position = projected
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(r.opts.pollInterval):
+		}
+	}
+}
+
+// CatchUp projects every event saved after the last checkpoint and returns once there are no more events.
+func (r *Runner) CatchUp(ctx context.Context) error {
+	if len(r.projectors) == 0 {
+		return ErrNoProjectors
+	}
+
+	position, err := r.loadCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.catchUp(ctx, position)
+	return err
+}
+
+// Rebuild resets every projector and the checkpoint, then projects every event from the start of the store.
+func (r *Runner) Rebuild(ctx context.Context) error {
+	if len(r.projectors) == 0 {
+		return ErrNoProjectors
+	}
+
+	r.log.Infof("rebuilding projections of runner {%s} from the first event", r.opts.name)
+	for _, projector := range r.projectors {
+		if err := projector.Reset(ctx); err != nil {
+			return fmt.Errorf("Reset {%s}: %w", projector.Name(), err)
+		}
+	}
+	if err := r.checkpoints.SaveCheckpoint(ctx, r.opts.name, events.LogPosition{}); err != nil {
+		return fmt.Errorf("SaveCheckpoint: %w", err)
+	}
+
+	_, err := r.catchUp(ctx, events.LogPosition{})
+	return err
+}
+
+func (r *Runner) loadCheckpoint(ctx context.Context) (events.LogPosition, error) {
+	position, err := r.checkpoints.LoadCheckpoint(ctx, r.opts.name)
+	if errors.Is(err, ErrCheckpointNotFound) {
+		return events.LogPosition{}, nil
+	}
+	if err != nil {
+		return events.LogPosition{}, fmt.Errorf("LoadCheckpoint: %w", err)
+	}
+	return position, nil
+}
+
+// catchUp projects batches of events until a batch comes back empty. it returns the position of the last event that
+// was checkpointed.
+func (r *Runner) catchUp(ctx context.Context, position events.LogPosition) (events.LogPosition, error) {
+	for {
+		var batch []events.Event
+		err := r.withRetries(ctx, "ReadAll", func() error {
+			var err error
+			batch, err = r.reader.ReadAll(ctx, position, r.opts.batchSize)
+			return err
+		})
+		if err != nil {
+			return position, err
+		}
+		if len(batch) == 0 {
+			return position, nil
+		}
+
+		for _, event := range batch {
+			if err = r.project(ctx, event); err != nil {
+				return position, err
+			}
+		}
+
+		last := batch[len(batch)-1].GetLogPosition()
+		err = r.withRetries(ctx, "SaveCheckpoint", func() error {
+			return r.checkpoints.SaveCheckpoint(ctx, r.opts.name, last)
+		})
+		if err != nil {
+			return position, err
+		}
+
+		r.log.Debugf("projection runner {%s} projected %d events up to position %d", r.opts.name, len(batch), last.Commit)
+		position = last
+	}
+}
+
+func (r *Runner) project(ctx context.Context, event events.Event) error {
+	upcasted, err := events.Upcast(event)
+	if err != nil {
+		// upcasting is deterministic, it fails for every projector on every attempt.
+		return r.deadLetter(ctx, "", event, fmt.Errorf("%w: Upcast: %v", ErrUndecodableEvent, err))
+	}
+	event = upcasted
+
+	for _, projector := range r.projectors {
+		operation := fmt.Sprintf("Project {%s} %s of {%s}", projector.Name(), event.GetEventType(), event.GetAggregateId())
+		err = r.withRetries(ctx, operation, func() error {
+			return projector.Project(ctx, event)
+		})
+		if isPermanent(err) {
+			err = r.deadLetter(ctx, projector.Name(), event, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deadLetter skips the event the projector failed to project permanently, after adding it to the DeadLetterStore of
+// the runner. an empty projector name means the event was skipped by every projector.
+func (r *Runner) deadLetter(ctx context.Context, projector string, event events.Event, cause error) error {
+	r.log.Errorf("projection runner {%s} skipped %s {%s} of {%s} at position %d: %v", r.opts.name, event.GetEventType(), event.GetEventId(), event.GetAggregateId(), event.GetPosition(), cause)
+	if r.opts.deadLetters == nil {
+		return nil
+	}
+
+	letter := NewDeadLetter(r.opts.name, projector, event, cause)
+	return r.withRetries(ctx, "AddDeadLetter", func() error {
+		return r.opts.deadLetters.AddDeadLetter(ctx, letter)
+	})
+}
+
+// isPermanent returns true if the error would be returned by every attempt, e.g. because the event can't be decoded.
+func isPermanent(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.Is(err, ErrUndecodableEvent) || errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
+// withRetries calls fn up to the maximum number of attempts, doubling the delay between attempts from the minimum
+// backoff up to the maximum backoff. permanent errors aren't retried.
+func (r *Runner) withRetries(ctx context.Context, operation string, fn func() error) error {
+	backoff := r.opts.minBackoff
+	var err error
+	for attempt := 1; attempt <= r.opts.maxAttempts; attempt++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if attempt == r.opts.maxAttempts || isPermanent(err) {
+			break
+		}
+
+		r.log.Warnf("%s failed (attempt %d/%d), retrying in %s: %v", operation, attempt, r.opts.maxAttempts, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > r.opts.maxBackoff {
+			backoff = r.opts.maxBackoff
+		}
+	}
+	return fmt.Errorf("%s: %w", operation, err)
+}
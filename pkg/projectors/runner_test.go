@@ -0,0 +1,142 @@
+package projectors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/datacenter"
+	"github.com/malijoe/DatacenterGenerator/pkg/components/projections"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// testProjector fails the first attempt at projecting every event, and fails permanently to decode the events of the
+// undecodable type.
+type testProjector struct {
+	attempts map[string]int
+}
+
+const (
+	undecodable events.EventType = "TEST_UNDECODABLE"
+	// the events of a version no upcaster can convert.
+	notUpcastable events.EventType = "TEST_NOT_UPCASTABLE"
+)
+
+func init() {
+	events.RegisterUpcaster(notUpcastable, func(event events.Event) (events.Event, error) {
+		return events.Event{}, errors.New("unknown version")
+	})
+}
+
+func (p *testProjector) Name() string {
+	return "test"
+}
+
+func (p *testProjector) Project(ctx context.Context, event events.Event) error {
+	p.attempts[event.GetEventId()]++
+	if p.attempts[event.GetEventId()] == 1 {
+		return errors.New("unavailable")
+	}
+	if event.GetEventType() == undecodable {
+		var data struct{}
+		return event.GetJsonData(&data)
+	}
+	return nil
+}
+
+func (p *testProjector) Reset(ctx context.Context) error {
+	return nil
+}
+
+func savePod(t *testing.T, store events.AggregateStore, id, function string) {
+	t.Helper()
+
+	dc := datacenter.NewDatacenter()
+	dc.ID = "dc1"
+	pod := podAggregate.NewPodAggregateWithId(id)
+	if err := pod.CreatePod(context.Background(), function, dc); err != nil {
+		t.Fatalf("CreatePod: %v", err)
+	}
+	if err := store.Save(context.Background(), pod); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+}
+
+func TestRunnerSkipsUndecodableEvents(t *testing.T) {
+	ctx := context.Background()
+	store := eventstore.NewMemoryStore()
+
+	savePod(t, store, "p1", "compute")
+	// the first can't be upcast and is skipped by every projector, the second can't be decoded by the test projector.
+	err := store.SaveEvents(ctx, []events.Event{
+		{EventId: "not-upcast", EventType: notUpcastable, AggregateId: "pod-p2", Data: []byte("{}")},
+	})
+	if err == nil {
+		err = store.SaveEvents(ctx, []events.Event{{EventId: "not-decoded", EventType: undecodable, AggregateId: "test", Data: []byte("{")}})
+	}
+	if err != nil {
+		t.Fatalf("SaveEvents: %v", err)
+	}
+	savePod(t, store, "p3", "storage")
+
+	pods := projections.NewMemoryPodRepository()
+	test := &testProjector{attempts: make(map[string]int)}
+	checkpoints := NewMemoryCheckpointStore()
+	deadLetters := NewMemoryDeadLetterStore()
+	runner := NewRunner(logger.NewLogger("test"), store, checkpoints, []Projector{test, NewPodProjector(pods)},
+		WithBackoff(time.Millisecond, time.Millisecond), WithDeadLetterStore(deadLetters))
+
+	if err = runner.CatchUp(ctx); err != nil {
+		t.Fatalf("CatchUp: %v", err)
+	}
+
+	for _, id := range []string{"p1", "p3"} {
+		if _, err = pods.GetById(ctx, id); err != nil {
+			t.Fatalf("GetById {%s}: %v", id, err)
+		}
+	}
+
+	letters := deadLetters.DeadLetters()
+	if len(letters) != 2 {
+		t.Fatalf("expected 2 dead letters, got %+v", letters)
+	}
+	if letters[0].Event.GetEventId() != "not-upcast" || letters[0].Projector != "" {
+		t.Fatalf("expected the event that can't be upcast to be skipped by every projector, got %+v", letters[0])
+	}
+	if letters[1].Event.GetEventId() != "not-decoded" || letters[1].Projector != "test" {
+		t.Fatalf("expected the event that can't be decoded to be skipped by the test projector, got %+v", letters[1])
+	}
+	// transient failures are retried, permanent ones aren't.
+	if test.attempts["not-decoded"] != 2 || test.attempts["not-upcast"] != 0 {
+		t.Fatalf("unexpected attempts %v", test.attempts)
+	}
+
+	checkpoint, err := checkpoints.LoadCheckpoint(ctx, defaultRunnerName)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint: %v", err)
+	}
+	if checkpoint.Commit != 4 {
+		t.Fatalf("expected the checkpoint at position 4, got %d", checkpoint.Commit)
+	}
+}
+
+func TestRunnerStopsOnTransientErrors(t *testing.T) {
+	ctx := context.Background()
+	store := eventstore.NewMemoryStore()
+	savePod(t, store, "p1", "compute")
+
+	test := &testProjector{attempts: make(map[string]int)}
+	checkpoints := NewMemoryCheckpointStore()
+	runner := NewRunner(logger.NewLogger("test"), store, checkpoints, []Projector{test}, WithMaxAttempts(1))
+
+	if err := runner.CatchUp(ctx); err == nil {
+		t.Fatalf("expected the runner to stop after its only attempt")
+	}
+	if _, err := checkpoints.LoadCheckpoint(ctx, defaultRunnerName); !errors.Is(err, ErrCheckpointNotFound) {
+		t.Fatalf("expected no checkpoint, got %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package sagas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/podAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	eventsv1 "github.com/malijoe/DatacenterGenerator/pkg/events/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+const (
+	datacenterMembershipSaga = "datacenterMembership"
+
+	datacenterIdKey = "datacenterId"
+)
+
+// datacenterMembership is a process manager that adds racks and pods to their datacenter once they have been created,
+// replacing the DatacenterAddRackCommand and DatacenterAddPodCommand that used to be issued by hand. when the
+// datacenter rejects the addition, the rack or pod is deleted so that it isn't left orphaned.
+//
+// datacenterMembership implements projectors.Projector so it can be fed by a projectors.Runner. the state of every
+// saga instance is saved before the datacenter is changed, and events that are delivered again are ignored once
+// their saga has finished.
+type datacenterMembership struct {
+	log    logger.Logger
+	states StateStore
+
+	addRack    v1.DatacenterAddRackCmdHandler
+	addPod     v1.DatacenterAddPodCmdHandler
+	deleteRack v1.DeleteRackCmdHandler
+	deletePod  v1.DeletePodCmdHandler
+}
+
+func NewDatacenterMembership(log logger.Logger, store events.AggregateStore, states StateStore, opts ...v1.HandlerOption) *datacenterMembership {
+	return &datacenterMembership{
+		log:        log,
+		states:     states,
+		addRack:    v1.NewDatacenterAddRackCmdHandler(store, log, opts...),
+		addPod:     v1.NewDatacenterAddPodCmdHandler(store, log, opts...),
+		deleteRack: v1.NewDeleteRackCmdHandler(store, log, opts...),
+		deletePod:  v1.NewDeletePodCmdHandler(store, log, opts...),
+	}
+}
+
+func (s *datacenterMembership) Name() string {
+	return datacenterMembershipSaga
+}
+
+func (s *datacenterMembership) Project(ctx context.Context, event events.Event) error {
+	switch event.GetEventType() {
+	case eventsv1.RackCreated:
+		return s.onRackCreated(ctx, event)
+	case eventsv1.PodCreated:
+		return s.onPodCreated(ctx, event)
+	default:
+		return nil
+	}
+}
+
+// Reset forgets the state of every saga instance. the additions are idempotent and racks and pods deleted since are
+// rejected, so replaying the events afterwards finishes the sagas again without changing the datacenters.
+func (s *datacenterMembership) Reset(ctx context.Context) error {
+	return s.states.DeleteAll(ctx)
+}
+
+func (s *datacenterMembership) onRackCreated(ctx context.Context, event events.Event) error {
+	var data eventsv1.RackCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+	rackId := rackAggregate.GetRackAggregateId(event.GetAggregateId())
+
+	add := func(ctx context.Context) error {
+		err := s.addRack.Handle(ctx, v1.NewDatacenterAddRackCommand(data.DatacenterId, rackId))
+		if errors.Is(err, datacenterAggregate.ErrRackAlreadyAdded) {
+			return nil
+		}
+		return err
+	}
+	compensate := func(ctx context.Context, reason string) error {
+		err := s.deleteRack.Handle(ctx, v1.NewDeleteRackCommand(rackId, reason))
+		if errors.Is(err, rackAggregate.ErrRackDeleted) {
+			return nil
+		}
+		return err
+	}
+
+	return s.run(ctx, event, data.DatacenterId, add, compensate)
+}
+
+func (s *datacenterMembership) onPodCreated(ctx context.Context, event events.Event) error {
+	var data eventsv1.PodCreatedEvent
+	if err := event.GetJsonData(&data); err != nil {
+		return err
+	}
+	podId := podAggregate.GetPodAggregateId(event.GetAggregateId())
+
+	add := func(ctx context.Context) error {
+		err := s.addPod.Handle(ctx, v1.NewDatacenterAddPodCommand(data.DatacenterId, podId))
+		if errors.Is(err, datacenterAggregate.ErrPodAlreadyAdded) {
+			return nil
+		}
+		return err
+	}
+	compensate := func(ctx context.Context, reason string) error {
+		err := s.deletePod.Handle(ctx, v1.NewDeletePodCommand(podId, reason))
+		if errors.Is(err, podAggregate.ErrPodDeleted) {
+			return nil
+		}
+		return err
+	}
+
+	return s.run(ctx, event, data.DatacenterId, add, compensate)
+}
+
+// run drives the saga instance started by the event. errors that aren't a rejection by the datacenter are returned
+// so that the event is delivered again, resuming the saga from its saved state.
+func (s *datacenterMembership) run(ctx context.Context, event events.Event, datacenterId string, add func(context.Context) error, compensate func(context.Context, string) error) error {
+	state, err := s.loadState(ctx, event, datacenterId)
+	if err != nil {
+		return err
+	}
+	if state.IsFinished() {
+		s.log.Debugf("saga {%s} of {%s} already %s", datacenterMembershipSaga, state.ID, state.Status)
+		return nil
+	}
+
+	state.Attempts++
+	if err = s.saveState(ctx, state); err != nil {
+		return err
+	}
+
+	ctx = sagaContext(ctx, event)
+
+	err = add(ctx)
+	switch {
+	case err == nil:
+		state.Status = StatusCompleted
+	case isRejection(err):
+		s.log.Warnf("datacenter {%s} rejected {%s}, compensating: %v", datacenterId, state.ID, err)
+		reason := fmt.Sprintf("rejected by datacenter {%s}: %v", datacenterId, err)
+		if compErr := compensate(ctx, reason); compErr != nil {
+			state.LastError = compErr.Error()
+			s.recordFailure(ctx, state)
+			return compErr
+		}
+		state.Status = StatusCompensated
+		state.LastError = err.Error()
+	default:
+		state.LastError = err.Error()
+		s.recordFailure(ctx, state)
+		return err
+	}
+
+	return s.saveState(ctx, state)
+}
+
+func (s *datacenterMembership) loadState(ctx context.Context, event events.Event, datacenterId string) (*State, error) {
+	state, err := s.states.LoadState(ctx, event.GetAggregateId())
+	if errors.Is(err, ErrStateNotFound) {
+		return &State{
+			ID:        event.GetAggregateId(),
+			Saga:      datacenterMembershipSaga,
+			Status:    StatusPending,
+			Data:      map[string]string{datacenterIdKey: datacenterId},
+			CreatedAt: time.Now(),
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoadState: %w", err)
+	}
+	return state, nil
+}
+
+func (s *datacenterMembership) saveState(ctx context.Context, state *State) error {
+	state.UpdatedAt = time.Now()
+	if err := s.states.SaveState(ctx, state); err != nil {
+		return fmt.Errorf("SaveState: %w", err)
+	}
+	return nil
+}
+
+// recordFailure saves the state of a failed attempt. the attempt is retried whether or not the state is saved, so
+// failing to save it is only logged.
+func (s *datacenterMembership) recordFailure(ctx context.Context, state *State) {
+	if err := s.saveState(ctx, state); err != nil {
+		s.log.Warnf("failed to record failed attempt of saga {%s} of {%s}: %v", datacenterMembershipSaga, state.ID, err)
+	}
+}
+
+// isRejection returns true if the datacenter can never accept the addition, no matter how often it is retried.
+func isRejection(err error) bool {
+	return errors.Is(err, events.ErrAggregateNotFound) ||
+		errors.Is(err, datacenterAggregate.ErrRackIDNotProvided) ||
+		errors.Is(err, datacenterAggregate.ErrPodIDNotProvided) ||
+		errors.Is(err, rackAggregate.ErrRackDeleted) ||
+		errors.Is(err, podAggregate.ErrPodDeleted)
+}
+
+// sagaContext returns a copy of ctx whose event metadata continues the correlation of the event and names it as the
+// cause of the commands issued by the saga.
+func sagaContext(ctx context.Context, event events.Event) context.Context {
+	var metadata events.EventMetadata
+	if len(event.GetMetadata()) > 0 {
+		// events saved without metadata still start a saga, they just start a new correlation.
+		_ = event.GetJsonMetadata(&metadata)
+	}
+	metadata.CausationId = event.GetEventId()
+	metadata.Actor = "saga:" + datacenterMembershipSaga
+	return events.ContextWithMetadata(ctx, metadata)
+}
@@ -0,0 +1,74 @@
+package sagas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/datacenterAggregate"
+	"github.com/malijoe/DatacenterGenerator/pkg/aggregates/rackAggregate"
+	v1 "github.com/malijoe/DatacenterGenerator/pkg/commands/v1"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/events"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/eventstore"
+	"github.com/malijoe/DatacenterGenerator/pkg/internal/logger"
+)
+
+// replay projects every event of the store with the saga.
+func replay(t *testing.T, store events.AllEventsReader, saga *datacenterMembership) {
+	t.Helper()
+	ctx := context.Background()
+
+	evts, err := store.ReadAll(ctx, events.LogPosition{}, 0)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	for _, event := range evts {
+		if err = saga.Project(ctx, event); err != nil {
+			t.Fatalf("Project {%s}: %v", event.GetEventType(), err)
+		}
+	}
+}
+
+func TestDatacenterMembershipReplay(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewLogger("test")
+	store := eventstore.NewMemoryStore()
+
+	if err := v1.NewInitDatacenterHandler(store, log).Handle(ctx, v1.NewInitDatacenterCommand("dc1", "dal1", "", "", nil)); err != nil {
+		t.Fatalf("InitDatacenter: %v", err)
+	}
+	for _, id := range []string{"r1", "r2"} {
+		if err := v1.NewCreateRackCmdHandler(store, log).Handle(ctx, v1.NewCreateRackCommand(id, id, 42, "dc1")); err != nil {
+			t.Fatalf("CreateRack: %v", err)
+		}
+	}
+	// r2 is deleted before the saga adds it to the datacenter.
+	if err := v1.NewDeleteRackCmdHandler(store, log).Handle(ctx, v1.NewDeleteRackCommand("r2", "test")); err != nil {
+		t.Fatalf("DeleteRack: %v", err)
+	}
+
+	states := NewMemoryStateStore()
+	saga := NewDatacenterMembership(log, store, states)
+	replay(t, store, saga)
+	if err := saga.Reset(ctx); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	replay(t, store, saga)
+
+	dc, err := datacenterAggregate.LoadDatacenterAggregate(ctx, store, "dc1")
+	if err != nil {
+		t.Fatalf("LoadDatacenterAggregate: %v", err)
+	}
+	if len(dc.Datacenter.Racks) != 1 || dc.Datacenter.Racks[0].ID != "r1" {
+		t.Fatalf("expected only {r1} in the datacenter, got %d racks", len(dc.Datacenter.Racks))
+	}
+
+	for id, status := range map[string]Status{"r1": StatusCompleted, "r2": StatusCompensated} {
+		state, err := states.LoadState(ctx, rackAggregate.NewRackAggregateWithId(id).GetId())
+		if err != nil {
+			t.Fatalf("LoadState {%s}: %v", id, err)
+		}
+		if state.Status != status {
+			t.Fatalf("expected {%s} to be %s, got %s", id, status, state.Status)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+package sagas
+
+import "errors"
+
+var ErrStateNotFound = errors.New("saga state not found")
@@ -0,0 +1,97 @@
+package sagas
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Status is the progress of a saga instance.
+type Status string
+
+const (
+	// StatusPending is the status of a saga that has started and hasn't finished yet. pending sagas are resumed
+	// when the event that started them is delivered again.
+	StatusPending Status = "pending"
+	// StatusCompleted is the status of a saga whose every step succeeded.
+	StatusCompleted Status = "completed"
+	// StatusCompensated is the status of a saga that was rejected and whose completed steps were undone.
+	StatusCompensated Status = "compensated"
+)
+
+// State is the durable state of a saga instance.
+type State struct {
+	// the id of the saga instance, the id of the stream of the event that started it.
+	ID string `json:"id" bson:"id"`
+	// the name of the saga.
+	Saga   string `json:"saga" bson:"saga"`
+	Status Status `json:"status" bson:"status"`
+	// the number of times the saga has been started.
+	Attempts int `json:"attempts" bson:"attempts"`
+	// the error that caused the saga to be compensated, or its last failed attempt.
+	LastError string `json:"lastError,omitempty" bson:"lastError,omitempty"`
+	// the data the saga needs to resume, keyed by name.
+	Data map[string]string `json:"data,omitempty" bson:"data,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+}
+
+// IsFinished returns true if the saga will take no further action.
+func (s *State) IsFinished() bool {
+	return s.Status == StatusCompleted || s.Status == StatusCompensated
+}
+
+// StateStore persists the state of saga instances.
+type StateStore interface {
+	// LoadState returns the state of the saga instance with the passed id. ErrStateNotFound is returned if the
+	// instance has never been saved.
+	LoadState(ctx context.Context, id string) (*State, error)
+	// SaveState inserts the state or replaces the state with the same id.
+	SaveState(ctx context.Context, state *State) error
+	// DeleteAll removes the state of every saga instance.
+	DeleteAll(ctx context.Context) error
+}
+
+// memoryStateStore is an in-process implementation of StateStore.
+type memoryStateStore struct {
+	mu     sync.RWMutex
+	states map[string]State
+}
+
+func NewMemoryStateStore() *memoryStateStore {
+	return &memoryStateStore{states: make(map[string]State)}
+}
+
+func (s *memoryStateStore) LoadState(ctx context.Context, id string) (*State, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state, ok := s.states[id]
+	if !ok {
+		return nil, fmt.Errorf("%w {%s}", ErrStateNotFound, id)
+	}
+	return &state, nil
+}
+
+func (s *memoryStateStore) SaveState(ctx context.Context, state *State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := *state
+	saved.Data = make(map[string]string, len(state.Data))
+	for k, v := range state.Data {
+		saved.Data[k] = v
+	}
+	s.states[state.ID] = saved
+	return nil
+}
+
+func (s *memoryStateStore) DeleteAll(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states = make(map[string]State)
+	return nil
+}